@@ -0,0 +1,329 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	achievementCollection = "achievements"
+	achievementKey        = "progress"
+
+	// Attempts at saving a game's progress, for when a concurrent write to the same objects gets in first.
+	achievementWriteAttempts = 3
+)
+
+// The result of a finished game from one player's point of view.
+type playerOutcome string
+
+const (
+	outcomeWin  playerOutcome = "win"
+	outcomeLoss playerOutcome = "loss"
+	outcomeDraw playerOutcome = "draw"
+)
+
+// Everything an achievement rule may inspect about a finished game.
+type achievementContext struct {
+	mark    api.Mark
+	outcome playerOutcome
+	// Number of marks this player placed during the game.
+	movesPlayed int
+	progress    *achievementProgress
+}
+
+// Per-user achievement state, kept in storage.
+type achievementProgress struct {
+	Wins          int64            `json:"wins"`
+	Draws         int64            `json:"draws"`
+	WinStreak     int64            `json:"win_streak"`
+	BestWinStreak int64            `json:"best_win_streak"`
+	Unlocked      map[string]int64 `json:"unlocked"`
+}
+
+type achievementDefinition struct {
+	id          string
+	name        string
+	description string
+	goal        int64
+	// Progress towards the goal for counting achievements, nil for one-off feats.
+	progress func(p *achievementProgress) int64
+	// Checks a single game for one-off feats, nil for counting achievements.
+	feat func(c *achievementContext) bool
+}
+
+// Beating the AI on hard difficulty is left out until there is an AI opponent to play against.
+var achievementDefinitions = []*achievementDefinition{
+	{
+		id:          "first_win",
+		name:        "First Victory",
+		description: "Win your first game.",
+		goal:        1,
+		progress:    func(p *achievementProgress) int64 { return p.Wins },
+	},
+	{
+		id:          "win_streak_10",
+		name:        "Unstoppable",
+		description: "Win 10 games in a row.",
+		goal:        10,
+		progress:    func(p *achievementProgress) int64 { return p.BestWinStreak },
+	},
+	{
+		id:          "win_in_3",
+		name:        "Clean Sweep",
+		description: "Win a game having placed only three marks.",
+		goal:        1,
+		feat: func(c *achievementContext) bool {
+			return c.outcome == outcomeWin && c.movesPlayed == 3
+		},
+	},
+	{
+		id:          "win_as_o",
+		name:        "Second Mover",
+		description: "Win a game playing as O.",
+		goal:        1,
+		feat: func(c *achievementContext) bool {
+			return c.outcome == outcomeWin && c.mark == api.Mark_MARK_O
+		},
+	},
+	{
+		id:          "draws_100",
+		name:        "Stalemate Specialist",
+		description: "Draw 100 games.",
+		goal:        100,
+		progress:    func(p *achievementProgress) int64 { return p.Draws },
+	},
+}
+
+// Work out each player's outcome for the game that just finished from their place. Only first place wins, and sharing
+// it is a draw.
+func gameOutcomes(s *MatchState) map[string]playerOutcome {
+	outcomes := make(map[string]playerOutcome, len(s.marks))
+	for userID := range s.marks {
+		switch place, tied := s.placement(userID); {
+		case place == 1 && tied == 1:
+			outcomes[userID] = outcomeWin
+		case place == 1:
+			outcomes[userID] = outcomeDraw
+		default:
+			outcomes[userID] = outcomeLoss
+		}
+	}
+	return outcomes
+}
+
+// Update every player's achievement progress with the game that just finished, and announce anything they unlocked.
+func evaluateAchievements(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, marshaler *protojson.MarshalOptions, s *MatchState) {
+	for userID, outcome := range gameOutcomes(s) {
+		achievementCtx := &achievementContext{
+			mark:    s.marks[userID],
+			outcome: outcome,
+		}
+		for _, move := range s.moves {
+			if move.UserID == userID {
+				achievementCtx.movesPlayed++
+			}
+		}
+
+		var unlocked []*achievementDefinition
+		var err error
+		for attempt := 0; attempt < achievementWriteAttempts; attempt++ {
+			if unlocked, err = updateAchievements(ctx, nk, userID, achievementCtx); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			logger.Error("error updating achievements for %v: %v", userID, err)
+			continue
+		}
+
+		for _, definition := range unlocked {
+			logger.Info("Achievement %v unlocked by %v", definition.id, userID)
+			achievement := definition.toApi(achievementCtx.progress)
+
+			content := map[string]interface{}{
+				"id":          achievement.Id,
+				"name":        achievement.Name,
+				"description": achievement.Description,
+			}
+			if err := nk.NotificationSend(ctx, userID, "Achievement unlocked!", content, notificationCodeAchievement, "", true); err != nil {
+				logger.Error("error sending achievement notification: %v", err)
+			}
+
+			if presence := s.presences[userID]; presence != nil {
+				buf, err := marshaler.Marshal(achievement)
+				if err != nil {
+					logger.Error("error encoding message: %v", err)
+				} else {
+					_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_ACHIEVEMENT), buf, []runtime.Presence{presence}, nil, true)
+				}
+			}
+		}
+	}
+}
+
+// Apply a game to a user's stored progress, returning the achievements it unlocked. Cosmetics the achievements grant
+// are saved in the same write, so an unlock is never recorded without them.
+func updateAchievements(ctx context.Context, nk runtime.NakamaModule, userID string, c *achievementContext) ([]*achievementDefinition, error) {
+	progress, version, err := readAchievementProgress(ctx, nk, userID)
+	if err != nil {
+		return nil, err
+	}
+	c.progress = progress
+
+	switch c.outcome {
+	case outcomeWin:
+		progress.Wins++
+		progress.WinStreak++
+		if progress.WinStreak > progress.BestWinStreak {
+			progress.BestWinStreak = progress.WinStreak
+		}
+	case outcomeLoss:
+		progress.WinStreak = 0
+	case outcomeDraw:
+		progress.Draws++
+		progress.WinStreak = 0
+	}
+
+	var unlocked []*achievementDefinition
+	now := time.Now().Unix()
+	for _, definition := range achievementDefinitions {
+		if _, ok := progress.Unlocked[definition.id]; ok {
+			continue
+		}
+		if definition.isUnlocked(c) {
+			progress.Unlocked[definition.id] = now
+			unlocked = append(unlocked, definition)
+		}
+	}
+
+	value, err := json.Marshal(progress)
+	if err != nil {
+		return nil, err
+	}
+	writes := []*runtime.StorageWrite{
+		{
+			Collection:      achievementCollection,
+			Key:             achievementKey,
+			UserID:          userID,
+			Value:           string(value),
+			Version:         version,
+			PermissionRead:  1, // Owner read
+			PermissionWrite: 0, // Only server can write
+		},
+	}
+	grant, err := achievementCosmeticsWrite(ctx, nk, userID, unlocked)
+	if err != nil {
+		return nil, err
+	}
+	if grant != nil {
+		writes = append(writes, grant)
+	}
+	if _, err := nk.StorageWrite(ctx, writes); err != nil {
+		return nil, err
+	}
+
+	return unlocked, nil
+}
+
+func readAchievementProgress(ctx context.Context, nk runtime.NakamaModule, userID string) (*achievementProgress, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: achievementCollection,
+			Key:        achievementKey,
+			UserID:     userID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	progress := &achievementProgress{}
+	version := "*"
+	if len(objects) > 0 {
+		if err := json.Unmarshal([]byte(objects[0].Value), progress); err != nil {
+			return nil, "", err
+		}
+		version = objects[0].Version
+	}
+	if progress.Unlocked == nil {
+		progress.Unlocked = make(map[string]int64)
+	}
+	return progress, version, nil
+}
+
+func (d *achievementDefinition) isUnlocked(c *achievementContext) bool {
+	if d.progress != nil {
+		return d.progress(c.progress) >= d.goal
+	}
+	return d.feat(c)
+}
+
+func (d *achievementDefinition) toApi(p *achievementProgress) *api.Achievement {
+	achievement := &api.Achievement{
+		Id:          d.id,
+		Name:        d.name,
+		Description: d.description,
+		Goal:        d.goal,
+		UnlockTime:  p.Unlocked[d.id],
+	}
+	achievement.Unlocked = achievement.UnlockTime != 0
+	if d.progress != nil {
+		achievement.Progress = d.progress(p)
+	} else if achievement.Unlocked {
+		achievement.Progress = d.goal
+	}
+	if achievement.Progress > d.goal {
+		achievement.Progress = d.goal
+	}
+	return achievement
+}
+
+// List every achievement along with the caller's progress.
+func rpcListAchievements(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		progress, _, err := readAchievementProgress(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading achievements: %v", err)
+			return "", errInternalError
+		}
+
+		response := &api.RpcListAchievementsResponse{
+			Achievements: make([]*api.Achievement, 0, len(achievementDefinitions)),
+		}
+		for _, definition := range achievementDefinitions {
+			response.Achievements = append(response.Achievements, definition.toApi(progress))
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The most matches listed at once. Nakama offers no cursor to page past this, so responses flag when it is reached.
+const adminMatchListLimit = 100
+
+// Admin RPCs may only be called server to server with the runtime HTTP key, never from a user session.
+func checkAdminCaller(ctx context.Context) error {
+	if userID, _ := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string); userID != "" {
+		return errPermissionDenied
+	}
+	return nil
+}
+
+// List every running match along with its label and players.
+func rpcAdminListMatches(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		matches, err := nk.MatchList(ctx, adminMatchListLimit, true, "", nil, nil, "")
+		if err != nil {
+			logger.Error("error listing matches: %v", err)
+			return "", errInternalError
+		}
+
+		inspect, err := marshaler.Marshal(&api.AdminSignal{Command: api.AdminCommand_ADMIN_COMMAND_INSPECT})
+		if err != nil {
+			logger.Error("error marshaling signal: %v", err)
+			return "", errMarshal
+		}
+
+		response := &api.RpcAdminListMatchesResponse{
+			Matches:   make([]*api.MatchSnapshot, 0, len(matches)),
+			Truncated: len(matches) >= adminMatchListLimit,
+		}
+		for _, match := range matches {
+			if match.GetHandlerName() != moduleName {
+				continue
+			}
+
+			snapshot := &api.MatchSnapshot{}
+			result, err := nk.MatchSignal(ctx, match.MatchId, string(inspect))
+			if err == nil && result != "" {
+				err = unmarshaler.Unmarshal([]byte(result), snapshot)
+			}
+			if err != nil || result == "" {
+				// The match may have ended since it was listed, fall back to what its label says.
+				logger.Warn("error inspecting match %v: %v", match.MatchId, err)
+				snapshot = &api.MatchSnapshot{
+					MatchId: match.MatchId,
+					Label:   match.GetLabel().GetValue(),
+				}
+				label := &MatchLabel{}
+				if err := json.Unmarshal([]byte(snapshot.Label), label); err == nil {
+					snapshot.Open = int32(label.Open)
+					snapshot.Fast = int32(label.Fast)
+					snapshot.Stake = label.Stake
+				}
+			}
+			response.Matches = append(response.Matches, snapshot)
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Read the full state of a single running match.
+func rpcAdminInspectMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return adminMatchRpc(marshaler, unmarshaler, func(request *api.RpcAdminMatchRequest) *api.AdminSignal {
+		return &api.AdminSignal{Command: api.AdminCommand_ADMIN_COMMAND_INSPECT}
+	})
+}
+
+// End the game in progress in a match with a declared result, which is applied as if the game had been played out.
+func rpcAdminEndMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return adminMatchRpc(marshaler, unmarshaler, func(request *api.RpcAdminMatchRequest) *api.AdminSignal {
+		return &api.AdminSignal{Command: api.AdminCommand_ADMIN_COMMAND_FORCE_END, Winner: request.Winner}
+	})
+}
+
+// Remove a player from a match.
+func rpcAdminKickPlayer(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return adminMatchRpc(marshaler, unmarshaler, func(request *api.RpcAdminMatchRequest) *api.AdminSignal {
+		if request.UserId == "" {
+			return nil
+		}
+		return &api.AdminSignal{Command: api.AdminCommand_ADMIN_COMMAND_KICK, UserId: request.UserId}
+	})
+}
+
+// Build an RPC which signals a command to a single match and responds with the match state once it has been applied.
+func adminMatchRpc(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions, command func(request *api.RpcAdminMatchRequest) *api.AdminSignal) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		request := &api.RpcAdminMatchRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.MatchId == "" {
+			return "", errInvalidInput
+		}
+		signal := command(request)
+		if signal == nil {
+			return "", errInvalidInput
+		}
+
+		data, err := marshaler.Marshal(signal)
+		if err != nil {
+			logger.Error("error marshaling signal: %v", err)
+			return "", errMarshal
+		}
+
+		match, err := nk.MatchGet(ctx, request.MatchId)
+		if err != nil || match == nil || match.GetHandlerName() != moduleName {
+			return "", errMatchNotFound
+		}
+
+		result, err := nk.MatchSignal(ctx, request.MatchId, string(data))
+		if err != nil {
+			logger.Error("error signalling match %v: %v", request.MatchId, err)
+			return "", errInternalError
+		}
+		if result == "" {
+			return "", errAdminCommandRejected
+		}
+		return result, nil
+	}
+}
+
+// Send an announcement, such as a maintenance notice, to everyone in every running match.
+func rpcAdminAnnounce(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		request := &api.RpcAdminAnnounceRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.Message == "" {
+			return "", errInvalidInput
+		}
+
+		data, err := marshaler.Marshal(&api.AdminSignal{
+			Command: api.AdminCommand_ADMIN_COMMAND_ANNOUNCE,
+			Message: request.Message,
+		})
+		if err != nil {
+			logger.Error("error marshaling signal: %v", err)
+			return "", errMarshal
+		}
+
+		matches, err := nk.MatchList(ctx, adminMatchListLimit, true, "", nil, nil, "")
+		if err != nil {
+			logger.Error("error listing matches: %v", err)
+			return "", errInternalError
+		}
+
+		response := &api.RpcAdminAnnounceResponse{
+			Truncated: len(matches) >= adminMatchListLimit,
+		}
+		for _, match := range matches {
+			if match.GetHandlerName() != moduleName {
+				continue
+			}
+			if _, err := nk.MatchSignal(ctx, match.MatchId, string(data)); err != nil {
+				logger.Warn("error signalling match %v: %v", match.MatchId, err)
+				continue
+			}
+			response.Matches++
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Apply an operator command to the match. The result is a snapshot of the match state after the command, or empty if
+// the command could not be applied.
+func (m *MatchHandler) handleAdminSignal(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, data string) string {
+	signal := &api.AdminSignal{}
+	if err := m.unmarshaler.Unmarshal([]byte(data), signal); err != nil {
+		logger.Warn("error decoding match signal: %v", err)
+		return ""
+	}
+	logger = logger.WithField("command", signal.Command.String())
+
+	switch signal.Command {
+	case api.AdminCommand_ADMIN_COMMAND_INSPECT:
+		// Nothing to apply, the snapshot is the result.
+
+	case api.AdminCommand_ADMIN_COMMAND_FORCE_END:
+		if !s.playing || s.closeReason != "" {
+			logger.Warn("no game in progress to end")
+			return ""
+		}
+		if signal.Winner != api.Mark_MARK_UNSPECIFIED && len(s.rotation[signal.Winner]) == 0 {
+			return ""
+		}
+
+		s.playing = false
+		s.winner = signal.Winner
+		s.winnerPositions = nil
+		s.deadlineRemainingTicks = 0
+		logger.Warn("game ended by operator with result %v", gameResult(s))
+		m.finishGame(ctx, logger, nk, dispatcher, s)
+		// The match closes on its next tick, as it would after any other game.
+		s.closeReason = matchCloseForceEnded
+
+	case api.AdminCommand_ADMIN_COMMAND_KICK:
+		presence := s.presences[signal.UserId]
+		if presence == nil {
+			return ""
+		}
+		if err := dispatcher.MatchKick([]runtime.Presence{presence}); err != nil {
+			logger.Error("error kicking player: %v", err)
+			return ""
+		}
+		logger.Warn("player %v kicked by operator", signal.UserId)
+
+	case api.AdminCommand_ADMIN_COMMAND_ANNOUNCE:
+		buf, err := m.marshaler.Marshal(&api.Announcement{Message: signal.Message})
+		if err != nil {
+			logger.Error("error encoding message: %v", err)
+			return ""
+		}
+		_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_ANNOUNCEMENT), buf, nil, nil, true)
+
+	default:
+		return ""
+	}
+
+	buf, err := m.marshaler.Marshal(s.snapshot(matchIdFromContext(ctx), tick))
+	if err != nil {
+		logger.Error("error encoding match snapshot: %v", err)
+		return ""
+	}
+	return string(buf)
+}
+
+func (s *MatchState) snapshot(matchID string, tick int64) *api.MatchSnapshot {
+	label, _ := json.Marshal(s.label)
+	snapshot := &api.MatchSnapshot{
+		MatchId:                matchID,
+		Label:                  string(label),
+		Open:                   int32(s.label.Open),
+		Fast:                   int32(s.label.Fast),
+		Stake:                  s.label.Stake,
+		Players:                make([]*api.MatchPlayer, 0, len(s.presences)),
+		Playing:                s.playing,
+		Board:                  s.board,
+		Mark:                   s.mark,
+		DeadlineRemainingTicks: s.deadlineRemainingTicks,
+		GameNumber:             int32(s.gameNumber),
+		Tick:                   tick,
+	}
+	for userID, presence := range s.presences {
+		player := &api.MatchPlayer{
+			UserId:    userID,
+			Mark:      s.marks[userID],
+			Connected: presence != nil,
+		}
+		if presence != nil {
+			player.Username = presence.GetUsername()
+		}
+		snapshot.Players = append(snapshot.Players, player)
+	}
+	return snapshot
+}
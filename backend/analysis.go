@@ -0,0 +1,260 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	errInvalidReplay      = errors.New("replay contains an illegal move")
+	errAnalysisNotClassic = errors.New("only classic games with standard rules can be analysed")
+)
+
+// Perfect-play solver for the 3x3 board. Positions are memoised by their base 3 encoding, which also determines
+// whose turn it is, so a single solver can be reused across a whole game.
+type solver struct {
+	memo map[int]int
+}
+
+func newSolver() *solver {
+	return &solver{memo: make(map[int]int)}
+}
+
+// The result the player to move can force: 1 for a win, 0 for a draw and -1 for a loss.
+func (v *solver) solve(board []api.Mark, toMove api.Mark) int {
+	key := boardKey(board)
+	if value, ok := v.memo[key]; ok {
+		return value
+	}
+
+	var value int
+	switch {
+	case winningLine(board, otherMark(toMove)) != nil:
+		value = -1
+	case isBoardFull(board):
+		value = 0
+	default:
+		value = -1
+		for position, mark := range board {
+			if mark != api.Mark_MARK_UNSPECIFIED {
+				continue
+			}
+			board[position] = toMove
+			if child := -v.solve(board, otherMark(toMove)); child > value {
+				value = child
+			}
+			board[position] = api.Mark_MARK_UNSPECIFIED
+			if value == 1 {
+				break
+			}
+		}
+	}
+
+	v.memo[key] = value
+	return value
+}
+
+// The result the player to move can force after playing each empty position, keyed by position.
+func (v *solver) moveValues(board []api.Mark, toMove api.Mark) map[int32]int {
+	values := make(map[int32]int, len(board))
+	for position, mark := range board {
+		if mark != api.Mark_MARK_UNSPECIFIED {
+			continue
+		}
+		board[position] = toMove
+		values[int32(position)] = -v.solve(board, otherMark(toMove))
+		board[position] = api.Mark_MARK_UNSPECIFIED
+	}
+	return values
+}
+
+func boardKey(board []api.Mark) int {
+	key := 0
+	for _, mark := range board {
+		key = key*3 + int(mark)
+	}
+	return key
+}
+
+func isBoardFull(board []api.Mark) bool {
+	for _, mark := range board {
+		if mark == api.Mark_MARK_UNSPECIFIED {
+			return false
+		}
+	}
+	return true
+}
+
+func otherMark(mark api.Mark) api.Mark {
+	if mark == api.Mark_MARK_X {
+		return api.Mark_MARK_O
+	}
+	return api.Mark_MARK_X
+}
+
+// Compare every move in a recorded game against perfect play, and summarise each player's accuracy and timing.
+func analyzeGame(replay *matchReplay) (*api.RpcAnalyzeGameResponse, error) {
+	if !replay.analysable() {
+		return nil, errAnalysisNotClassic
+	}
+	v := newSolver()
+	board := make([]api.Mark, 9)
+	response := &api.RpcAnalyzeGameResponse{
+		MatchId: replay.MatchID,
+		Moves:   make([]*api.MoveAnalysis, 0, len(replay.Moves)),
+	}
+
+	players := make(map[string]*api.PlayerAnalysis, len(replay.Marks))
+	for _, mark := range []api.Mark{api.Mark_MARK_X, api.Mark_MARK_O} {
+		for userID, playerMark := range replay.Marks {
+			if playerMark == mark {
+				players[userID] = &api.PlayerAnalysis{UserId: userID, Mark: mark}
+				response.Players = append(response.Players, players[userID])
+			}
+		}
+	}
+
+	for i, move := range replay.Moves {
+		if move.Position < 0 || int(move.Position) >= len(board) || board[move.Position] != api.Mark_MARK_UNSPECIFIED {
+			return nil, errInvalidReplay
+		}
+
+		best := v.solve(board, move.Mark)
+		values := v.moveValues(board, move.Mark)
+		played := values[move.Position]
+
+		analysis := &api.MoveAnalysis{
+			MoveNumber: int32(i + 1),
+			UserId:     move.UserID,
+			Mark:       move.Mark,
+			Position:   move.Position,
+			MissedWin:  best == 1 && played < 1,
+			ElapsedMs:  move.ElapsedMs,
+		}
+		for position := range board {
+			if value, ok := values[int32(position)]; ok && value == best {
+				analysis.BestPositions = append(analysis.BestPositions, int32(position))
+			}
+		}
+		switch {
+		case played == best:
+			analysis.Quality = api.MoveQuality_MOVE_QUALITY_BEST
+		case played < 0:
+			analysis.Quality = api.MoveQuality_MOVE_QUALITY_BLUNDER
+		default:
+			analysis.Quality = api.MoveQuality_MOVE_QUALITY_INACCURACY
+		}
+		response.Moves = append(response.Moves, analysis)
+
+		if player, ok := players[move.UserID]; ok {
+			switch analysis.Quality {
+			case api.MoveQuality_MOVE_QUALITY_BEST:
+				player.Best++
+			case api.MoveQuality_MOVE_QUALITY_INACCURACY:
+				player.Inaccuracies++
+			case api.MoveQuality_MOVE_QUALITY_BLUNDER:
+				player.Blunders++
+			}
+			if analysis.MissedWin {
+				player.MissedWins++
+			}
+			player.TotalTimeMs += move.ElapsedMs
+		}
+
+		board[move.Position] = move.Mark
+	}
+
+	for _, player := range players {
+		moves := player.Best + player.Inaccuracies + player.Blunders
+		if moves > 0 {
+			player.Accuracy = 100 * float64(player.Best) / float64(moves)
+			player.AverageTimeMs = player.TotalTimeMs / int64(moves)
+		}
+	}
+	return response, nil
+}
+
+func (r *matchReplay) analysable() bool {
+	return r.Mode == api.GameMode_GAME_MODE_CLASSIC && r.Variant == api.Variant_VARIANT_STANDARD && r.Players <= minPlayers
+}
+
+func readMatchReplay(ctx context.Context, nk runtime.NakamaModule, matchID string) (*matchReplay, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: matchReplayCollection,
+			Key:        matchID,
+		},
+	})
+	if err != nil || len(objects) == 0 {
+		return nil, err
+	}
+
+	replay := &matchReplay{}
+	if err := json.Unmarshal([]byte(objects[0].Value), replay); err != nil {
+		return nil, err
+	}
+	return replay, nil
+}
+
+// Analyse a finished game move by move against perfect play.
+func rpcAnalyzeGame(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if _, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string); !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcAnalyzeGameRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.MatchId == "" {
+			return "", errInvalidInput
+		}
+
+		replay, err := readMatchReplay(ctx, nk, request.MatchId)
+		if err != nil {
+			logger.Error("error reading replay: %v", err)
+			return "", errInternalError
+		}
+		if replay == nil {
+			return "", errMatchNotFound
+		}
+
+		response, err := analyzeGame(replay)
+		if err == errAnalysisNotClassic {
+			// The solver only knows the classic board and rules.
+			return "", errInvalidInput
+		}
+		if err != nil {
+			logger.Error("error analysing replay %v: %v", request.MatchId, err)
+			return "", errInternalError
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
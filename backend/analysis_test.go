@@ -0,0 +1,150 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// A classic game between users "x" and "o", X moving first.
+func testReplay(positions ...int32) *matchReplay {
+	replay := &matchReplay{Marks: map[string]api.Mark{"x": api.Mark_MARK_X, "o": api.Mark_MARK_O}}
+	for i, position := range positions {
+		move := &moveRecord{UserID: "x", Mark: api.Mark_MARK_X, Position: position, ElapsedMs: int64(1000 * (i + 1))}
+		if i%2 == 1 {
+			move.UserID, move.Mark = "o", api.Mark_MARK_O
+		}
+		replay.Moves = append(replay.Moves, move)
+	}
+	return replay
+}
+
+// Build a board from a picture of its rows, using "X", "O" and "." for empty cells.
+func testBoard(rows string) []api.Mark {
+	board := make([]api.Mark, 0, 9)
+	for _, c := range rows {
+		switch c {
+		case 'X':
+			board = append(board, api.Mark_MARK_X)
+		case 'O':
+			board = append(board, api.Mark_MARK_O)
+		case '.':
+			board = append(board, api.Mark_MARK_UNSPECIFIED)
+		}
+	}
+	return board
+}
+
+func TestSolve(t *testing.T) {
+	tests := []struct {
+		name   string
+		board  string
+		toMove api.Mark
+		want   int
+	}{
+		{"empty board is a draw", "... ... ...", api.Mark_MARK_X, 0},
+		{"win in one", "XX. OO. ...", api.Mark_MARK_X, 1},
+		{"opponent wins in one", "XX. OO. X..", api.Mark_MARK_O, 1},
+		{"already lost", "XXX OO. ...", api.Mark_MARK_O, -1},
+		{"full board draw", "XOX XOO OXX", api.Mark_MARK_O, 0},
+		{"edge reply to a corner loses", "XO. ... ...", api.Mark_MARK_X, 1},
+		{"centre reply to a corner draws", "X.. .O. ...", api.Mark_MARK_X, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newSolver().solve(testBoard(tt.board), tt.toMove); got != tt.want {
+				t.Errorf("solve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeGameQuality(t *testing.T) {
+	tests := []struct {
+		name      string
+		moves     []int32
+		quality   api.MoveQuality
+		missedWin bool
+		best      []int32
+	}{
+		{"any opening is best", []int32{0}, api.MoveQuality_MOVE_QUALITY_BEST, false, []int32{0, 1, 2, 3, 4, 5, 6, 7, 8}},
+		{"centre against a corner", []int32{0, 4}, api.MoveQuality_MOVE_QUALITY_BEST, false, []int32{4}},
+		{"edge against a corner", []int32{0, 1}, api.MoveQuality_MOVE_QUALITY_BLUNDER, false, []int32{4}},
+		{"corner against the centre", []int32{4, 0}, api.MoveQuality_MOVE_QUALITY_BEST, false, []int32{0, 2, 6, 8}},
+		{"converting a won position", []int32{0, 1, 4}, api.MoveQuality_MOVE_QUALITY_BEST, false, []int32{3, 4, 6}},
+		{"letting a won position draw", []int32{0, 1, 8}, api.MoveQuality_MOVE_QUALITY_INACCURACY, true, []int32{3, 4, 6}},
+		{"missing a win in one and losing", []int32{0, 3, 1, 4, 8}, api.MoveQuality_MOVE_QUALITY_BLUNDER, true, []int32{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := analyzeGame(testReplay(tt.moves...))
+			if err != nil {
+				t.Fatalf("analyzeGame() error = %v", err)
+			}
+			last := response.Moves[len(response.Moves)-1]
+			if last.Quality != tt.quality {
+				t.Errorf("quality = %v, want %v", last.Quality, tt.quality)
+			}
+			if last.MissedWin != tt.missedWin {
+				t.Errorf("missed win = %v, want %v", last.MissedWin, tt.missedWin)
+			}
+			if !slices.Equal(last.BestPositions, tt.best) {
+				t.Errorf("best positions = %v, want %v", last.BestPositions, tt.best)
+			}
+		})
+	}
+}
+
+func TestAnalyzeGamePlayers(t *testing.T) {
+	response, err := analyzeGame(testReplay(0, 1, 8))
+	if err != nil {
+		t.Fatalf("analyzeGame() error = %v", err)
+	}
+	if len(response.Players) != 2 || response.Players[0].UserId != "x" || response.Players[1].UserId != "o" {
+		t.Fatalf("players = %v, want x then o", response.Players)
+	}
+
+	x, o := response.Players[0], response.Players[1]
+	if x.Best != 1 || x.Inaccuracies != 1 || x.MissedWins != 1 || x.Accuracy != 50 || x.TotalTimeMs != 4000 || x.AverageTimeMs != 2000 {
+		t.Errorf("x = %v", x)
+	}
+	if o.Blunders != 1 || o.Accuracy != 0 || o.TotalTimeMs != 2000 || o.AverageTimeMs != 2000 {
+		t.Errorf("o = %v", o)
+	}
+}
+
+func TestAnalyzeGameErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		replay *matchReplay
+		want   error
+	}{
+		{"position taken twice", testReplay(0, 0), errInvalidReplay},
+		{"position off the board", testReplay(9), errInvalidReplay},
+		{"ultimate game", &matchReplay{Mode: api.GameMode_GAME_MODE_ULTIMATE}, errAnalysisNotClassic},
+		{"misere game", &matchReplay{Variant: api.Variant_VARIANT_MISERE}, errAnalysisNotClassic},
+		{"multi-player game", &matchReplay{Players: 3}, errAnalysisNotClassic},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := analyzeGame(tt.replay); err != tt.want {
+				t.Errorf("analyzeGame() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
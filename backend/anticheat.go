@@ -0,0 +1,439 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	anticheatCollection = "anticheat"
+	anticheatKey        = "profile"
+	// Flagged and excluded accounts are indexed in system-owned storage, keyed by user ID, so operators can list them.
+	anticheatFlagCollection = "anticheat_flags"
+
+	anticheatDefaultLimit = 20
+	anticheatMaxLimit     = 100
+
+	// Moves made quicker than this are faster than a human plausibly reads the board.
+	anticheatFastMoveMs = 300
+	// Games against the same opponent are counted over this window when looking for farming.
+	anticheatPairingWindowSec = 24 * 60 * 60
+
+	flagPerfectPlay       = "perfect_play"
+	flagFastMoves         = "fast_moves"
+	flagRepeatedPairing   = "repeated_pairing"
+	flagIntentionalLosing = "intentional_losing"
+)
+
+type anticheatHeuristic struct {
+	flag string
+	// Added to the suspicion score while the heuristic is tripped.
+	weight  int32
+	tripped func(p *anticheatProfile) bool
+}
+
+// Each heuristic needs enough samples before it can trip.
+var anticheatHeuristics = []*anticheatHeuristic{
+	{
+		flag:    flagPerfectPlay,
+		weight:  25,
+		tripped: func(p *anticheatProfile) bool { return p.Games >= 20 && p.perfectRate() >= 0.9 },
+	},
+	{
+		flag:    flagFastMoves,
+		weight:  35,
+		tripped: func(p *anticheatProfile) bool { return p.Moves >= 30 && p.fastMoveRate() >= 0.5 },
+	},
+	{
+		flag:   flagRepeatedPairing,
+		weight: 20,
+		tripped: func(p *anticheatProfile) bool {
+			_, games := p.topOpponent()
+			return games >= 10
+		},
+	},
+	{
+		flag:    flagIntentionalLosing,
+		weight:  20,
+		tripped: func(p *anticheatProfile) bool { return p.Losses >= 5 && p.ignoredThreatRate() >= 0.6 },
+	},
+}
+
+// Per-user counters behind the anti-cheat heuristics, kept in storage.
+type anticheatProfile struct {
+	Games        int64 `json:"games"`
+	PerfectGames int64 `json:"perfect_games"`
+	Moves        int64 `json:"moves"`
+	FastMoves    int64 `json:"fast_moves"`
+	Losses       int64 `json:"losses"`
+	// Losses in which the player let the opponent complete a line they could have blocked. Genuine losses in
+	// tic-tac-toe nearly always come from a fork instead.
+	IgnoredThreatLosses int64 `json:"ignored_threat_losses"`
+	// End times of recent games against each opponent, pruned to the pairing window.
+	Pairings   map[string][]int64 `json:"pairings"`
+	Excluded   bool               `json:"excluded"`
+	UpdateTime int64              `json:"update_time"`
+}
+
+func (p *anticheatProfile) perfectRate() float64 {
+	return rate(p.PerfectGames, p.Games)
+}
+
+func (p *anticheatProfile) fastMoveRate() float64 {
+	return rate(p.FastMoves, p.Moves)
+}
+
+func (p *anticheatProfile) ignoredThreatRate() float64 {
+	return rate(p.IgnoredThreatLosses, p.Losses)
+}
+
+func (p *anticheatProfile) topOpponent() (string, int64) {
+	var topID string
+	var topGames int64
+	for opponentID, times := range p.Pairings {
+		if games := int64(len(times)); games > topGames || (games == topGames && opponentID < topID) {
+			topID, topGames = opponentID, games
+		}
+	}
+	return topID, topGames
+}
+
+func (p *anticheatProfile) report(userID string) *api.SuspicionReport {
+	report := &api.SuspicionReport{
+		UserId:            userID,
+		Games:             p.Games,
+		PerfectRate:       p.perfectRate(),
+		FastMoveRate:      p.fastMoveRate(),
+		IgnoredThreatRate: p.ignoredThreatRate(),
+		Excluded:          p.Excluded,
+		UpdateTime:        p.UpdateTime,
+	}
+	report.TopOpponentId, report.TopOpponentGames = p.topOpponent()
+	for _, heuristic := range anticheatHeuristics {
+		if heuristic.tripped(p) {
+			report.Flags = append(report.Flags, heuristic.flag)
+			report.Score += heuristic.weight
+		}
+	}
+	return report
+}
+
+func rate(count, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+// Positions where the given mark would complete a line on its next move.
+func immediateWins(board []api.Mark, mark api.Mark) map[int32]bool {
+	wins := make(map[int32]bool)
+	for _, winningPosition := range winningPositions {
+		var marked int
+		empty := int32(-1)
+		for _, position := range winningPosition {
+			switch board[position] {
+			case mark:
+				marked++
+			case api.Mark_MARK_UNSPECIFIED:
+				empty = position
+			}
+		}
+		if marked == len(winningPosition)-1 && empty >= 0 {
+			wins[empty] = true
+		}
+	}
+	return wins
+}
+
+// Count the moves in a finished game where each player neither won nor blocked an opponent who was one move from a
+// line.
+func ignoredThreats(moves []*moveRecord) map[string]int {
+	ignored := make(map[string]int, 2)
+	board := make([]api.Mark, 9)
+	for _, move := range moves {
+		if move.Position < 0 || int(move.Position) >= len(board) {
+			break
+		}
+		threats := immediateWins(board, otherMark(move.Mark))
+		if len(threats) > 0 && !threats[move.Position] && len(immediateWins(board, move.Mark)) == 0 {
+			ignored[move.UserID]++
+		}
+		board[move.Position] = move.Mark
+	}
+	return ignored
+}
+
+// Update every player's anti-cheat counters with the game that just finished, flagging anyone who now looks
+// suspicious.
+func updateAnticheat(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, s *MatchState) {
+	// Move quality can only be judged in classic games with standard rules, others just feed the timing and pairing
+	// heuristics.
+	replay := &matchReplay{Mode: api.GameMode(s.label.Mode), Variant: api.Variant(s.label.Variant), Players: len(s.marks), Marks: s.marks, Moves: s.moves}
+	analysed := replay.analysable()
+	accuracy := make(map[string]*api.PlayerAnalysis, len(s.marks))
+	var ignored map[string]int
+	if analysed {
+		analysis, err := analyzeGame(replay)
+		if err != nil {
+			logger.Error("error analysing game for anti-cheat: %v", err)
+			return
+		}
+		for _, player := range analysis.Players {
+			accuracy[player.UserId] = player
+		}
+		ignored = ignoredThreats(s.moves)
+	}
+
+	now := time.Now().Unix()
+	for userID, outcome := range gameOutcomes(s) {
+		profile, version, err := readAnticheatProfile(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading anti-cheat profile for %v: %v", userID, err)
+			continue
+		}
+
+		if analysed {
+			profile.Games++
+			if player := accuracy[userID]; player != nil && player.Inaccuracies == 0 && player.Blunders == 0 && player.Best >= 3 {
+				profile.PerfectGames++
+			}
+		}
+		for _, move := range s.moves {
+			if move.UserID != userID {
+				continue
+			}
+			profile.Moves++
+			if move.ElapsedMs < anticheatFastMoveMs {
+				profile.FastMoves++
+			}
+		}
+		if analysed && outcome == outcomeLoss {
+			profile.Losses++
+			if ignored[userID] > 0 {
+				profile.IgnoredThreatLosses++
+			}
+		}
+		for opponentID, times := range profile.Pairings {
+			recent := times[:0]
+			for _, t := range times {
+				if t > now-anticheatPairingWindowSec {
+					recent = append(recent, t)
+				}
+			}
+			if len(recent) == 0 {
+				delete(profile.Pairings, opponentID)
+			} else {
+				profile.Pairings[opponentID] = recent
+			}
+		}
+		for opponentID := range s.marks {
+			if opponentID != userID {
+				profile.Pairings[opponentID] = append(profile.Pairings[opponentID], now)
+			}
+		}
+		profile.UpdateTime = now
+
+		if err := writeAnticheatProfile(ctx, nk, marshaler, userID, profile, version); err != nil {
+			logger.Error("error writing anti-cheat profile for %v: %v", userID, err)
+		}
+	}
+}
+
+func readAnticheatProfile(ctx context.Context, nk runtime.NakamaModule, userID string) (*anticheatProfile, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: anticheatCollection,
+			Key:        anticheatKey,
+			UserID:     userID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	profile := &anticheatProfile{}
+	version := "*"
+	if len(objects) > 0 {
+		if err := json.Unmarshal([]byte(objects[0].Value), profile); err != nil {
+			return nil, "", err
+		}
+		version = objects[0].Version
+	}
+	if profile.Pairings == nil {
+		profile.Pairings = make(map[string][]int64)
+	}
+	return profile, version, nil
+}
+
+// Save a profile, and keep the account's entry in the flagged index in step with it.
+func writeAnticheatProfile(ctx context.Context, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, userID string, profile *anticheatProfile, version string) error {
+	value, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	writes := []*runtime.StorageWrite{
+		{
+			Collection:      anticheatCollection,
+			Key:             anticheatKey,
+			UserID:          userID,
+			Value:           string(value),
+			Version:         version,
+			PermissionRead:  0, // Only server can read
+			PermissionWrite: 0, // Only server can write
+		},
+	}
+
+	report := profile.report(userID)
+	if len(report.Flags) == 0 && !report.Excluded {
+		if _, err := nk.StorageWrite(ctx, writes); err != nil {
+			return err
+		}
+		return nk.StorageDelete(ctx, []*runtime.StorageDelete{
+			{
+				Collection: anticheatFlagCollection,
+				Key:        userID,
+			},
+		})
+	}
+
+	flag, err := marshaler.Marshal(report)
+	if err != nil {
+		return err
+	}
+	writes = append(writes, &runtime.StorageWrite{
+		Collection:      anticheatFlagCollection,
+		Key:             userID,
+		Value:           string(flag),
+		PermissionRead:  0, // Only server can read
+		PermissionWrite: 0, // Only server can write
+	})
+	_, err = nk.StorageWrite(ctx, writes)
+	return err
+}
+
+func anticheatExcluded(ctx context.Context, nk runtime.NakamaModule, userID string) (bool, error) {
+	profile, _, err := readAnticheatProfile(ctx, nk, userID)
+	if err != nil {
+		return false, err
+	}
+	return profile.Excluded, nil
+}
+
+// List accounts flagged by the anti-cheat heuristics or excluded from the leaderboards.
+func rpcAdminListFlagged(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		request := &api.RpcAdminListFlaggedRequest{}
+		if payload != "" {
+			if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+				return "", errUnmarshal
+			}
+		}
+
+		limit := int(request.Limit)
+		if limit <= 0 {
+			limit = anticheatDefaultLimit
+		} else if limit > anticheatMaxLimit {
+			limit = anticheatMaxLimit
+		}
+
+		objects, cursor, err := nk.StorageList(ctx, "", "", anticheatFlagCollection, limit, request.Cursor)
+		if err != nil {
+			logger.Error("error listing flagged accounts: %v", err)
+			return "", errInternalError
+		}
+
+		response := &api.RpcAdminListFlaggedResponse{
+			Reports: make([]*api.SuspicionReport, 0, len(objects)),
+			Cursor:  cursor,
+		}
+		for _, object := range objects {
+			report := &api.SuspicionReport{}
+			if err := unmarshaler.Unmarshal([]byte(object.Value), report); err != nil {
+				logger.Warn("error decoding suspicion report %v: %v", object.Key, err)
+				continue
+			}
+			response.Reports = append(response.Reports, report)
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Keep an account off the leaderboards, removing its existing records, or allow it back.
+func rpcAdminExcludePlayer(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		request := &api.RpcAdminExcludePlayerRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.UserId == "" {
+			return "", errInvalidInput
+		}
+
+		profile, version, err := readAnticheatProfile(ctx, nk, request.UserId)
+		if err != nil {
+			logger.Error("error reading anti-cheat profile: %v", err)
+			return "", errInternalError
+		}
+		profile.Excluded = request.Excluded
+		profile.UpdateTime = time.Now().Unix()
+		if err := writeAnticheatProfile(ctx, nk, marshaler, request.UserId, profile, version); err != nil {
+			logger.Error("error writing anti-cheat profile: %v", err)
+			return "", errInternalError
+		}
+
+		if request.Excluded {
+			if err := nk.LeaderboardRecordDelete(ctx, leaderboardId, request.UserId); err != nil {
+				logger.Warn("error deleting leaderboard entry: %v", err)
+			}
+			if account, err := nk.AccountGetId(ctx, request.UserId); err != nil {
+				logger.Error("error reading account: %v", err)
+			} else if country := normalizeCountry(account.GetUser().GetLocation()); country != "" {
+				if err := nk.LeaderboardRecordDelete(ctx, countryLeaderboardId(country), request.UserId); err != nil {
+					logger.Warn("error deleting country leaderboard entry: %v", err)
+				}
+			}
+		}
+
+		buf, err := marshaler.Marshal(profile.report(request.UserId))
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
@@ -46,6 +46,10 @@ const (
 	Mark_MARK_X Mark = 1
 	// O (Nought).
 	Mark_MARK_O Mark = 2
+	// Triangle, the third player in multi-player matches.
+	Mark_MARK_TRIANGLE Mark = 3
+	// Square, the fourth player in multi-player matches.
+	Mark_MARK_SQUARE Mark = 4
 )
 
 // Enum value maps for Mark.
@@ -54,11 +58,15 @@ var (
 		0: "MARK_UNSPECIFIED",
 		1: "MARK_X",
 		2: "MARK_O",
+		3: "MARK_TRIANGLE",
+		4: "MARK_SQUARE",
 	}
 	Mark_value = map[string]int32{
 		"MARK_UNSPECIFIED": 0,
 		"MARK_X":           1,
 		"MARK_O":           2,
+		"MARK_TRIANGLE":    3,
+		"MARK_SQUARE":      4,
 	}
 )
 
@@ -89,6 +97,59 @@ func (Mark) EnumDescriptor() ([]byte, []int) {
 	return file_xoxoapi_proto_rawDescGZIP(), []int{0}
 }
 
+// The kinds of cosmetic items players can own and equip.
+type CosmeticType int32
+
+const (
+	// No type specified. Unused.
+	CosmeticType_COSMETIC_TYPE_UNSPECIFIED CosmeticType = 0
+	// Changes how a player's X or O mark is drawn.
+	CosmeticType_COSMETIC_TYPE_MARK_SKIN CosmeticType = 1
+	// Changes how the board is drawn.
+	CosmeticType_COSMETIC_TYPE_BOARD_THEME CosmeticType = 2
+)
+
+// Enum value maps for CosmeticType.
+var (
+	CosmeticType_name = map[int32]string{
+		0: "COSMETIC_TYPE_UNSPECIFIED",
+		1: "COSMETIC_TYPE_MARK_SKIN",
+		2: "COSMETIC_TYPE_BOARD_THEME",
+	}
+	CosmeticType_value = map[string]int32{
+		"COSMETIC_TYPE_UNSPECIFIED": 0,
+		"COSMETIC_TYPE_MARK_SKIN":   1,
+		"COSMETIC_TYPE_BOARD_THEME": 2,
+	}
+)
+
+func (x CosmeticType) Enum() *CosmeticType {
+	p := new(CosmeticType)
+	*p = x
+	return p
+}
+
+func (x CosmeticType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CosmeticType) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[1].Descriptor()
+}
+
+func (CosmeticType) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[1]
+}
+
+func (x CosmeticType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CosmeticType.Descriptor instead.
+func (CosmeticType) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{1}
+}
+
 // The complete set of opcodes used for communication between clients and server.
 type OpCode int32
 
@@ -109,19 +170,40 @@ const (
 	OpCode_OPCODE_OPPONENT_LEFT OpCode = 6
 	// Invite AI player to join instead of the opponent who left the game.
 	OpCode_OPCODE_INVITE_AI OpCode = 7
+	// The receiving player unlocked an achievement.
+	OpCode_OPCODE_ACHIEVEMENT OpCode = 8
+	// A chat message or emote, sent by a player and relayed by the server to everyone else in the match.
+	OpCode_OPCODE_CHAT OpCode = 9
+	// A player mutes or unmutes another player's chat.
+	OpCode_OPCODE_MUTE OpCode = 10
+	// An operator announcement, such as upcoming maintenance.
+	OpCode_OPCODE_ANNOUNCEMENT OpCode = 11
+	// The server is shutting down and the match will end.
+	OpCode_OPCODE_SHUTDOWN OpCode = 12
+	// The server accepted a move.
+	OpCode_OPCODE_MOVE_ACK OpCode = 13
+	// A chat message or emote only delivered to the sender's teammates.
+	OpCode_OPCODE_TEAM_CHAT OpCode = 14
 )
 
 // Enum value maps for OpCode.
 var (
 	OpCode_name = map[int32]string{
-		0: "OPCODE_UNSPECIFIED",
-		1: "OPCODE_START",
-		2: "OPCODE_UPDATE",
-		3: "OPCODE_DONE",
-		4: "OPCODE_MOVE",
-		5: "OPCODE_REJECTED",
-		6: "OPCODE_OPPONENT_LEFT",
-		7: "OPCODE_INVITE_AI",
+		0:  "OPCODE_UNSPECIFIED",
+		1:  "OPCODE_START",
+		2:  "OPCODE_UPDATE",
+		3:  "OPCODE_DONE",
+		4:  "OPCODE_MOVE",
+		5:  "OPCODE_REJECTED",
+		6:  "OPCODE_OPPONENT_LEFT",
+		7:  "OPCODE_INVITE_AI",
+		8:  "OPCODE_ACHIEVEMENT",
+		9:  "OPCODE_CHAT",
+		10: "OPCODE_MUTE",
+		11: "OPCODE_ANNOUNCEMENT",
+		12: "OPCODE_SHUTDOWN",
+		13: "OPCODE_MOVE_ACK",
+		14: "OPCODE_TEAM_CHAT",
 	}
 	OpCode_value = map[string]int32{
 		"OPCODE_UNSPECIFIED":   0,
@@ -132,6 +214,13 @@ var (
 		"OPCODE_REJECTED":      5,
 		"OPCODE_OPPONENT_LEFT": 6,
 		"OPCODE_INVITE_AI":     7,
+		"OPCODE_ACHIEVEMENT":   8,
+		"OPCODE_CHAT":          9,
+		"OPCODE_MUTE":          10,
+		"OPCODE_ANNOUNCEMENT":  11,
+		"OPCODE_SHUTDOWN":      12,
+		"OPCODE_MOVE_ACK":      13,
+		"OPCODE_TEAM_CHAT":     14,
 	}
 )
 
@@ -146,11 +235,11 @@ func (x OpCode) String() string {
 }
 
 func (OpCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_xoxoapi_proto_enumTypes[1].Descriptor()
+	return file_xoxoapi_proto_enumTypes[2].Descriptor()
 }
 
 func (OpCode) Type() protoreflect.EnumType {
-	return &file_xoxoapi_proto_enumTypes[1]
+	return &file_xoxoapi_proto_enumTypes[2]
 }
 
 func (x OpCode) Number() protoreflect.EnumNumber {
@@ -159,256 +248,688 @@ func (x OpCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OpCode.Descriptor instead.
 func (OpCode) EnumDescriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{1}
+	return file_xoxoapi_proto_rawDescGZIP(), []int{2}
 }
 
-// Message data sent by server to clients representing a new game round starting.
-type Start struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Operator commands sent to a running match as a signal.
+type AdminCommand int32
 
-	// The current state of the board.
-	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
-	// The assignments of the marks to players for this round.
-	Marks map[string]Mark `protobuf:"bytes,2,rep,name=marks,proto3" json:"marks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=api.Mark"`
-	// Whose turn it is to play.
-	Mark Mark `protobuf:"varint,3,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
-	// The deadline time by which the player must submit their move, or forfeit.
-	Deadline int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
-}
+const (
+	// No command specified. Unused.
+	AdminCommand_ADMIN_COMMAND_UNSPECIFIED AdminCommand = 0
+	// Report the match state.
+	AdminCommand_ADMIN_COMMAND_INSPECT AdminCommand = 1
+	// End the game in progress with a declared result.
+	AdminCommand_ADMIN_COMMAND_FORCE_END AdminCommand = 2
+	// Remove a player from the match.
+	AdminCommand_ADMIN_COMMAND_KICK AdminCommand = 3
+	// Send an announcement to everyone in the match.
+	AdminCommand_ADMIN_COMMAND_ANNOUNCE AdminCommand = 4
+)
 
-func (x *Start) Reset() {
-	*x = Start{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AdminCommand.
+var (
+	AdminCommand_name = map[int32]string{
+		0: "ADMIN_COMMAND_UNSPECIFIED",
+		1: "ADMIN_COMMAND_INSPECT",
+		2: "ADMIN_COMMAND_FORCE_END",
+		3: "ADMIN_COMMAND_KICK",
+		4: "ADMIN_COMMAND_ANNOUNCE",
+	}
+	AdminCommand_value = map[string]int32{
+		"ADMIN_COMMAND_UNSPECIFIED": 0,
+		"ADMIN_COMMAND_INSPECT":     1,
+		"ADMIN_COMMAND_FORCE_END":   2,
+		"ADMIN_COMMAND_KICK":        3,
+		"ADMIN_COMMAND_ANNOUNCE":    4,
 	}
+)
+
+func (x AdminCommand) Enum() *AdminCommand {
+	p := new(AdminCommand)
+	*p = x
+	return p
 }
 
-func (x *Start) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AdminCommand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Start) ProtoMessage() {}
+func (AdminCommand) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[3].Descriptor()
+}
 
-func (x *Start) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AdminCommand) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[3]
 }
 
-// Deprecated: Use Start.ProtoReflect.Descriptor instead.
-func (*Start) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{0}
+func (x AdminCommand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Start) GetBoard() []Mark {
-	if x != nil {
-		return x.Board
-	}
-	return nil
+// Deprecated: Use AdminCommand.Descriptor instead.
+func (AdminCommand) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Start) GetMarks() map[string]Mark {
-	if x != nil {
-		return x.Marks
+// The result of a finished game from one player's point of view.
+type GameResult int32
+
+const (
+	// No result specified.
+	GameResult_GAME_RESULT_UNSPECIFIED GameResult = 0
+	// The player won.
+	GameResult_GAME_RESULT_WIN GameResult = 1
+	// The player lost.
+	GameResult_GAME_RESULT_LOSS GameResult = 2
+	// The game was drawn.
+	GameResult_GAME_RESULT_DRAW GameResult = 3
+)
+
+// Enum value maps for GameResult.
+var (
+	GameResult_name = map[int32]string{
+		0: "GAME_RESULT_UNSPECIFIED",
+		1: "GAME_RESULT_WIN",
+		2: "GAME_RESULT_LOSS",
+		3: "GAME_RESULT_DRAW",
 	}
-	return nil
+	GameResult_value = map[string]int32{
+		"GAME_RESULT_UNSPECIFIED": 0,
+		"GAME_RESULT_WIN":         1,
+		"GAME_RESULT_LOSS":        2,
+		"GAME_RESULT_DRAW":        3,
+	}
+)
+
+func (x GameResult) Enum() *GameResult {
+	p := new(GameResult)
+	*p = x
+	return p
 }
 
-func (x *Start) GetMark() Mark {
-	if x != nil {
-		return x.Mark
-	}
-	return Mark_MARK_UNSPECIFIED
+func (x GameResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Start) GetDeadline() int64 {
-	if x != nil {
-		return x.Deadline
-	}
-	return 0
+func (GameResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[4].Descriptor()
 }
 
-// A game state update sent by the server to clients.
-type Update struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GameResult) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[4]
+}
 
-	// The current state of the board.
-	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
-	// Whose turn it is to play.
-	Mark Mark `protobuf:"varint,2,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
-	// The deadline time by which the player must submit their move, or forfeit.
-	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+func (x GameResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Update) Reset() {
-	*x = Update{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use GameResult.Descriptor instead.
+func (GameResult) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{4}
+}
+
+// Why the server rejected a message from a player.
+type RejectReason int32
+
+const (
+	// No reason given.
+	RejectReason_REJECT_REASON_UNSPECIFIED RejectReason = 0
+	// The message could not be decoded, or its content is not allowed.
+	RejectReason_REJECT_REASON_INVALID_MESSAGE RejectReason = 1
+	// The opcode is not one clients may send.
+	RejectReason_REJECT_REASON_UNEXPECTED_OPCODE RejectReason = 2
+	// It is not the player's turn.
+	RejectReason_REJECT_REASON_NOT_YOUR_TURN RejectReason = 3
+	// The position is outside the board or already taken.
+	RejectReason_REJECT_REASON_INVALID_POSITION RejectReason = 4
+	// The player is sending messages too quickly. Further messages are dropped without a reply until they slow down.
+	RejectReason_REJECT_REASON_RATE_LIMITED RejectReason = 5
+	// The move was made against a turn that has already been played.
+	RejectReason_REJECT_REASON_STALE_MOVE RejectReason = 6
+	// The mark or value is not one the player may place under the match's rules.
+	RejectReason_REJECT_REASON_INVALID_PIECE RejectReason = 7
+	// The player's clock ran out before their move arrived.
+	RejectReason_REJECT_REASON_OUT_OF_TIME RejectReason = 8
+)
+
+// Enum value maps for RejectReason.
+var (
+	RejectReason_name = map[int32]string{
+		0: "REJECT_REASON_UNSPECIFIED",
+		1: "REJECT_REASON_INVALID_MESSAGE",
+		2: "REJECT_REASON_UNEXPECTED_OPCODE",
+		3: "REJECT_REASON_NOT_YOUR_TURN",
+		4: "REJECT_REASON_INVALID_POSITION",
+		5: "REJECT_REASON_RATE_LIMITED",
+		6: "REJECT_REASON_STALE_MOVE",
+		7: "REJECT_REASON_INVALID_PIECE",
+		8: "REJECT_REASON_OUT_OF_TIME",
+	}
+	RejectReason_value = map[string]int32{
+		"REJECT_REASON_UNSPECIFIED":       0,
+		"REJECT_REASON_INVALID_MESSAGE":   1,
+		"REJECT_REASON_UNEXPECTED_OPCODE": 2,
+		"REJECT_REASON_NOT_YOUR_TURN":     3,
+		"REJECT_REASON_INVALID_POSITION":  4,
+		"REJECT_REASON_RATE_LIMITED":      5,
+		"REJECT_REASON_STALE_MOVE":        6,
+		"REJECT_REASON_INVALID_PIECE":     7,
+		"REJECT_REASON_OUT_OF_TIME":       8,
 	}
+)
+
+func (x RejectReason) Enum() *RejectReason {
+	p := new(RejectReason)
+	*p = x
+	return p
 }
 
-func (x *Update) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Update) ProtoMessage() {}
+func (RejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[5].Descriptor()
+}
 
-func (x *Update) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RejectReason) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[5]
 }
 
-// Deprecated: Use Update.ProtoReflect.Descriptor instead.
-func (*Update) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{1}
+func (x RejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Update) GetBoard() []Mark {
-	if x != nil {
-		return x.Board
-	}
-	return nil
+// Deprecated: Use RejectReason.Descriptor instead.
+func (RejectReason) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Update) GetMark() Mark {
-	if x != nil {
-		return x.Mark
+// The quick emotes players can send during a match.
+type Emote int32
+
+const (
+	// No emote, the chat message carries text instead.
+	Emote_EMOTE_UNSPECIFIED Emote = 0
+	// Hello!
+	Emote_EMOTE_HELLO Emote = 1
+	// Good game.
+	Emote_EMOTE_GOOD_GAME Emote = 2
+	// Well played.
+	Emote_EMOTE_WELL_PLAYED Emote = 3
+	// Oops.
+	Emote_EMOTE_OOPS Emote = 4
+	// Thinking...
+	Emote_EMOTE_THINKING Emote = 5
+	// Thanks!
+	Emote_EMOTE_THANKS Emote = 6
+)
+
+// Enum value maps for Emote.
+var (
+	Emote_name = map[int32]string{
+		0: "EMOTE_UNSPECIFIED",
+		1: "EMOTE_HELLO",
+		2: "EMOTE_GOOD_GAME",
+		3: "EMOTE_WELL_PLAYED",
+		4: "EMOTE_OOPS",
+		5: "EMOTE_THINKING",
+		6: "EMOTE_THANKS",
 	}
-	return Mark_MARK_UNSPECIFIED
+	Emote_value = map[string]int32{
+		"EMOTE_UNSPECIFIED": 0,
+		"EMOTE_HELLO":       1,
+		"EMOTE_GOOD_GAME":   2,
+		"EMOTE_WELL_PLAYED": 3,
+		"EMOTE_OOPS":        4,
+		"EMOTE_THINKING":    5,
+		"EMOTE_THANKS":      6,
+	}
+)
+
+func (x Emote) Enum() *Emote {
+	p := new(Emote)
+	*p = x
+	return p
 }
 
-func (x *Update) GetDeadline() int64 {
-	if x != nil {
-		return x.Deadline
-	}
-	return 0
+func (x Emote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Complete game round with winner announcement.
-type Done struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (Emote) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[6].Descriptor()
+}
 
-	// The final state of the board.
-	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
-	// The winner of the game, if any. Unspecified if it's a draw.
-	Winner Mark `protobuf:"varint,2,opt,name=winner,proto3,enum=api.Mark" json:"winner,omitempty"`
-	// Winner board positions, if any. Used to display the row, column, or diagonal that won the game.
-	// May be empty if it's a draw or the winner is by forfeit.
-	WinnerPositions []int32 `protobuf:"varint,3,rep,packed,name=winner_positions,json=winnerPositions,proto3" json:"winner_positions,omitempty"`
-	// Next round start time.
-	NextGameStart int64 `protobuf:"varint,4,opt,name=next_game_start,json=nextGameStart,proto3" json:"next_game_start,omitempty"`
+func (Emote) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[6]
 }
 
-func (x *Done) Reset() {
-	*x = Done{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x Emote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Done) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Emote.Descriptor instead.
+func (Emote) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{6}
 }
 
-func (*Done) ProtoMessage() {}
+// The rules a game is played by.
+type GameMode int32
 
-func (x *Done) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// Classic tic-tac-toe on a single 3x3 board.
+	GameMode_GAME_MODE_CLASSIC GameMode = 0
+	// Ultimate tic-tac-toe: a 3x3 grid of 3x3 sub-boards. Each move sends the opponent to the sub-board matching the
+	// cell just played, and winning three sub-boards in a row wins the game.
+	GameMode_GAME_MODE_ULTIMATE GameMode = 1
+	// 3D tic-tac-toe on a 3x3x3 cube, with 49 winning lines.
+	GameMode_GAME_MODE_CUBE_3 GameMode = 2
+	// 3D tic-tac-toe on a 4x4x4 cube, where four in a row wins, with 76 winning lines.
+	GameMode_GAME_MODE_CUBE_4 GameMode = 3
+)
+
+// Enum value maps for GameMode.
+var (
+	GameMode_name = map[int32]string{
+		0: "GAME_MODE_CLASSIC",
+		1: "GAME_MODE_ULTIMATE",
+		2: "GAME_MODE_CUBE_3",
+		3: "GAME_MODE_CUBE_4",
 	}
-	return mi.MessageOf(x)
+	GameMode_value = map[string]int32{
+		"GAME_MODE_CLASSIC":  0,
+		"GAME_MODE_ULTIMATE": 1,
+		"GAME_MODE_CUBE_3":   2,
+		"GAME_MODE_CUBE_4":   3,
+	}
+)
+
+func (x GameMode) Enum() *GameMode {
+	p := new(GameMode)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Done.ProtoReflect.Descriptor instead.
-func (*Done) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{2}
+func (x GameMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Done) GetBoard() []Mark {
-	if x != nil {
-		return x.Board
-	}
-	return nil
+func (GameMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[7].Descriptor()
 }
 
-func (x *Done) GetWinner() Mark {
-	if x != nil {
-		return x.Winner
-	}
-	return Mark_MARK_UNSPECIFIED
+func (GameMode) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[7]
 }
 
-func (x *Done) GetWinnerPositions() []int32 {
-	if x != nil {
-		return x.WinnerPositions
-	}
-	return nil
+func (x GameMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Done) GetNextGameStart() int64 {
-	if x != nil {
-		return x.NextGameStart
-	}
-	return 0
+// Deprecated: Use GameMode.Descriptor instead.
+func (GameMode) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{7}
 }
 
-// A player intends to make a move.
-type Move struct {
-	state         protoimpl.MessageState
+// How a multi-player game is decided.
+type WinRule int32
+
+const (
+	// The first player to complete a line wins, and everyone else shares second place.
+	WinRule_WIN_RULE_FIRST_LINE WinRule = 0
+	// Each player to complete a line takes the best place still open and stops playing, and the rest play on until one
+	// player is left or the board is full.
+	WinRule_WIN_RULE_ELIMINATION WinRule = 1
+)
+
+// Enum value maps for WinRule.
+var (
+	WinRule_name = map[int32]string{
+		0: "WIN_RULE_FIRST_LINE",
+		1: "WIN_RULE_ELIMINATION",
+	}
+	WinRule_value = map[string]int32{
+		"WIN_RULE_FIRST_LINE":  0,
+		"WIN_RULE_ELIMINATION": 1,
+	}
+)
+
+func (x WinRule) Enum() *WinRule {
+	p := new(WinRule)
+	*p = x
+	return p
+}
+
+func (x WinRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WinRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[8].Descriptor()
+}
+
+func (WinRule) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[8]
+}
+
+func (x WinRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WinRule.Descriptor instead.
+func (WinRule) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{8}
+}
+
+// Named time controls, setting how much time each side has for its moves in a game.
+type TimeControl int32
+
+const (
+	// Each turn gets a fixed time, shorter in fast matches. Only players in fast matches lose for running out of it.
+	TimeControl_TIME_CONTROL_UNSPECIFIED TimeControl = 0
+	// 15 seconds on each side's clock, plus 1 second per move.
+	TimeControl_TIME_CONTROL_BULLET TimeControl = 1
+	// 1 minute on each side's clock, plus 2 seconds per move.
+	TimeControl_TIME_CONTROL_BLITZ TimeControl = 2
+	// 3 minutes on each side's clock, with a 5 second delay on every move.
+	TimeControl_TIME_CONTROL_CASUAL TimeControl = 3
+)
+
+// Enum value maps for TimeControl.
+var (
+	TimeControl_name = map[int32]string{
+		0: "TIME_CONTROL_UNSPECIFIED",
+		1: "TIME_CONTROL_BULLET",
+		2: "TIME_CONTROL_BLITZ",
+		3: "TIME_CONTROL_CASUAL",
+	}
+	TimeControl_value = map[string]int32{
+		"TIME_CONTROL_UNSPECIFIED": 0,
+		"TIME_CONTROL_BULLET":      1,
+		"TIME_CONTROL_BLITZ":       2,
+		"TIME_CONTROL_CASUAL":      3,
+	}
+)
+
+func (x TimeControl) Enum() *TimeControl {
+	p := new(TimeControl)
+	*p = x
+	return p
+}
+
+func (x TimeControl) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeControl) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[9].Descriptor()
+}
+
+func (TimeControl) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[9]
+}
+
+func (x TimeControl) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeControl.Descriptor instead.
+func (TimeControl) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{9}
+}
+
+// How time is given back to a side's clock after each of its moves.
+type IncrementMode int32
+
+const (
+	// Fischer: the increment is added in full.
+	IncrementMode_INCREMENT_MODE_FISCHER IncrementMode = 0
+	// Bronstein: the time the move took is given back, up to the increment.
+	IncrementMode_INCREMENT_MODE_BRONSTEIN IncrementMode = 1
+)
+
+// Enum value maps for IncrementMode.
+var (
+	IncrementMode_name = map[int32]string{
+		0: "INCREMENT_MODE_FISCHER",
+		1: "INCREMENT_MODE_BRONSTEIN",
+	}
+	IncrementMode_value = map[string]int32{
+		"INCREMENT_MODE_FISCHER":   0,
+		"INCREMENT_MODE_BRONSTEIN": 1,
+	}
+)
+
+func (x IncrementMode) Enum() *IncrementMode {
+	p := new(IncrementMode)
+	*p = x
+	return p
+}
+
+func (x IncrementMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IncrementMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[10].Descriptor()
+}
+
+func (IncrementMode) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[10]
+}
+
+func (x IncrementMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IncrementMode.Descriptor instead.
+func (IncrementMode) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{10}
+}
+
+// Where a party's members are placed in the match found for them.
+type PartyPlacement int32
+
+const (
+	// Teammates in team games, opponents otherwise.
+	PartyPlacement_PARTY_PLACEMENT_UNSPECIFIED PartyPlacement = 0
+	// On the same team. Only for team games, and only as many members as fit on a team.
+	PartyPlacement_PARTY_PLACEMENT_TEAMMATES PartyPlacement = 1
+	// On opposing sides, as evenly spread as the match allows.
+	PartyPlacement_PARTY_PLACEMENT_OPPONENTS PartyPlacement = 2
+)
+
+// Enum value maps for PartyPlacement.
+var (
+	PartyPlacement_name = map[int32]string{
+		0: "PARTY_PLACEMENT_UNSPECIFIED",
+		1: "PARTY_PLACEMENT_TEAMMATES",
+		2: "PARTY_PLACEMENT_OPPONENTS",
+	}
+	PartyPlacement_value = map[string]int32{
+		"PARTY_PLACEMENT_UNSPECIFIED": 0,
+		"PARTY_PLACEMENT_TEAMMATES":   1,
+		"PARTY_PLACEMENT_OPPONENTS":   2,
+	}
+)
+
+func (x PartyPlacement) Enum() *PartyPlacement {
+	p := new(PartyPlacement)
+	*p = x
+	return p
+}
+
+func (x PartyPlacement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartyPlacement) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[11].Descriptor()
+}
+
+func (PartyPlacement) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[11]
+}
+
+func (x PartyPlacement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartyPlacement.Descriptor instead.
+func (PartyPlacement) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{11}
+}
+
+// Variations on the classic rules. Variants other than standard are only played in classic mode.
+type Variant int32
+
+const (
+	// Three in a row wins.
+	Variant_VARIANT_STANDARD Variant = 0
+	// Misère: completing three in a row loses.
+	Variant_VARIANT_MISERE Variant = 1
+	// Wild: each move may place either mark, and whoever completes three in a row of either mark wins.
+	Variant_VARIANT_WILD Variant = 2
+	// Numerical: X places the odd digits 1-9 and O the even ones, each digit at most once, and whoever completes a line
+	// of three digits summing to 15 wins.
+	Variant_VARIANT_NUMERICAL Variant = 3
+)
+
+// Enum value maps for Variant.
+var (
+	Variant_name = map[int32]string{
+		0: "VARIANT_STANDARD",
+		1: "VARIANT_MISERE",
+		2: "VARIANT_WILD",
+		3: "VARIANT_NUMERICAL",
+	}
+	Variant_value = map[string]int32{
+		"VARIANT_STANDARD":  0,
+		"VARIANT_MISERE":    1,
+		"VARIANT_WILD":      2,
+		"VARIANT_NUMERICAL": 3,
+	}
+)
+
+func (x Variant) Enum() *Variant {
+	p := new(Variant)
+	*p = x
+	return p
+}
+
+func (x Variant) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Variant) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[12].Descriptor()
+}
+
+func (Variant) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[12]
+}
+
+func (x Variant) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Variant.Descriptor instead.
+func (Variant) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{12}
+}
+
+// How a move compares to perfect play.
+type MoveQuality int32
+
+const (
+	// Not analysed.
+	MoveQuality_MOVE_QUALITY_UNSPECIFIED MoveQuality = 0
+	// The move keeps the best result available.
+	MoveQuality_MOVE_QUALITY_BEST MoveQuality = 1
+	// The move gives up a forced win, but does not lose.
+	MoveQuality_MOVE_QUALITY_INACCURACY MoveQuality = 2
+	// The move turns a position that could not be lost into a lost one.
+	MoveQuality_MOVE_QUALITY_BLUNDER MoveQuality = 3
+)
+
+// Enum value maps for MoveQuality.
+var (
+	MoveQuality_name = map[int32]string{
+		0: "MOVE_QUALITY_UNSPECIFIED",
+		1: "MOVE_QUALITY_BEST",
+		2: "MOVE_QUALITY_INACCURACY",
+		3: "MOVE_QUALITY_BLUNDER",
+	}
+	MoveQuality_value = map[string]int32{
+		"MOVE_QUALITY_UNSPECIFIED": 0,
+		"MOVE_QUALITY_BEST":        1,
+		"MOVE_QUALITY_INACCURACY":  2,
+		"MOVE_QUALITY_BLUNDER":     3,
+	}
+)
+
+func (x MoveQuality) Enum() *MoveQuality {
+	p := new(MoveQuality)
+	*p = x
+	return p
+}
+
+func (x MoveQuality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoveQuality) Descriptor() protoreflect.EnumDescriptor {
+	return file_xoxoapi_proto_enumTypes[13].Descriptor()
+}
+
+func (MoveQuality) Type() protoreflect.EnumType {
+	return &file_xoxoapi_proto_enumTypes[13]
+}
+
+func (x MoveQuality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoveQuality.Descriptor instead.
+func (MoveQuality) EnumDescriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{13}
+}
+
+// The dimensions of the board. Cells are numbered layer by layer, then row by row, so the cell at (layer, row, column)
+// is position (layer * rows + row) * columns + column. Flat boards have a single layer, and in ultimate each sub-board
+// is a layer.
+type BoardShape struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The position the player wants to place their mark in.
-	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// Number of layers.
+	Layers int32 `protobuf:"varint,1,opt,name=layers,proto3" json:"layers,omitempty"`
+	// Number of rows in each layer.
+	Rows int32 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Number of columns in each row.
+	Columns int32 `protobuf:"varint,3,opt,name=columns,proto3" json:"columns,omitempty"`
 }
 
-func (x *Move) Reset() {
-	*x = Move{}
+func (x *BoardShape) Reset() {
+	*x = BoardShape{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[3]
+		mi := &file_xoxoapi_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Move) String() string {
+func (x *BoardShape) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Move) ProtoMessage() {}
+func (*BoardShape) ProtoMessage() {}
 
-func (x *Move) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[3]
+func (x *BoardShape) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,47 +940,63 @@ func (x *Move) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Move.ProtoReflect.Descriptor instead.
-func (*Move) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BoardShape.ProtoReflect.Descriptor instead.
+func (*BoardShape) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Move) GetPosition() int32 {
+func (x *BoardShape) GetLayers() int32 {
 	if x != nil {
-		return x.Position
+		return x.Layers
 	}
 	return 0
 }
 
-// Payload for an RPC request to find a match.
-type RpcFindMatchRequest struct {
+func (x *BoardShape) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *BoardShape) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+// A cell addressed by its coordinates rather than its position.
+type Coordinates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// User can choose a fast or normal speed match.
-	Fast bool `protobuf:"varint,1,opt,name=fast,proto3" json:"fast,omitempty"`
-	// User can choose whether to play with AI
-	Ai bool `protobuf:"varint,2,opt,name=ai,proto3" json:"ai,omitempty"`
+	// The layer, starting at 0.
+	Layer int32 `protobuf:"varint,1,opt,name=layer,proto3" json:"layer,omitempty"`
+	// The row within the layer, starting at 0.
+	Row int32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// The column within the row, starting at 0.
+	Column int32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
 }
 
-func (x *RpcFindMatchRequest) Reset() {
-	*x = RpcFindMatchRequest{}
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[4]
+		mi := &file_xoxoapi_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RpcFindMatchRequest) String() string {
+func (x *Coordinates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RpcFindMatchRequest) ProtoMessage() {}
+func (*Coordinates) ProtoMessage() {}
 
-func (x *RpcFindMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[4]
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,52 +1007,98 @@ func (x *RpcFindMatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RpcFindMatchRequest.ProtoReflect.Descriptor instead.
-func (*RpcFindMatchRequest) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RpcFindMatchRequest) GetFast() bool {
+func (x *Coordinates) GetLayer() int32 {
 	if x != nil {
-		return x.Fast
+		return x.Layer
 	}
-	return false
+	return 0
 }
 
-func (x *RpcFindMatchRequest) GetAi() bool {
+func (x *Coordinates) GetRow() int32 {
 	if x != nil {
-		return x.Ai
+		return x.Row
 	}
-	return false
+	return 0
 }
 
-// Payload for an RPC response containing match IDs the user can join.
-type RpcFindMatchResponse struct {
+func (x *Coordinates) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+// Message data sent by server to clients representing a new game round starting.
+type Start struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// One or more matches that fit the user's request.
-	MatchIds []string `protobuf:"bytes,1,rep,name=match_ids,json=matchIds,proto3" json:"match_ids,omitempty"`
+	// The current state of the board.
+	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
+	// The assignments of the marks to players for this round.
+	Marks map[string]Mark `protobuf:"bytes,2,rep,name=marks,proto3" json:"marks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=api.Mark"`
+	// Whose turn it is to play.
+	Mark Mark `protobuf:"varint,3,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// The deadline time by which the player must submit their move, or forfeit.
+	Deadline int64 `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// The cosmetics each player has equipped, keyed by user ID.
+	Cosmetics map[string]*CosmeticSelection `protobuf:"bytes,5,rep,name=cosmetics,proto3" json:"cosmetics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The chat channel holding this match's event log.
+	ChannelId string `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The number of the next turn to be played, starting at 1.
+	Turn int32 `protobuf:"varint,7,opt,name=turn,proto3" json:"turn,omitempty"`
+	// The rules the game is played by.
+	Mode GameMode `protobuf:"varint,8,opt,name=mode,proto3,enum=api.GameMode" json:"mode,omitempty"`
+	// Ultimate only: the sub-board the player to move must play in, or -1 if they may play in any open sub-board.
+	ActiveBoard int32 `protobuf:"varint,9,opt,name=active_board,json=activeBoard,proto3" json:"active_board,omitempty"`
+	// Ultimate only: the winner of each sub-board, unspecified while it is undecided or if it was drawn.
+	SubBoardWinners []Mark `protobuf:"varint,10,rep,packed,name=sub_board_winners,json=subBoardWinners,proto3,enum=api.Mark" json:"sub_board_winners,omitempty"`
+	// The rule variant the game is played with.
+	Variant Variant `protobuf:"varint,11,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// Numerical only: the digit in each cell, zero if empty.
+	Values []int32 `protobuf:"varint,12,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// The dimensions of the board.
+	Shape *BoardShape `protobuf:"bytes,13,opt,name=shape,proto3" json:"shape,omitempty"`
+	// How the game is decided when more than two players take part. Turns pass in mark order: X, O, triangle, square.
+	WinRule WinRule `protobuf:"varint,14,opt,name=win_rule,json=winRule,proto3,enum=api.WinRule" json:"win_rule,omitempty"`
+	// The user ID of the player to move. Teammates sharing a mark take turns in a fixed rotation.
+	TurnUserId string `protobuf:"bytes,15,opt,name=turn_user_id,json=turnUserId,proto3" json:"turn_user_id,omitempty"`
+	// The order each mark's players take their turns in, keyed by mark number. Only set in team games.
+	Rotations map[int32]*TeamRotation `protobuf:"bytes,16,rep,name=rotations,proto3" json:"rotations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The time control the game is played with.
+	TimeControl TimeControl `protobuf:"varint,17,opt,name=time_control,json=timeControl,proto3,enum=api.TimeControl" json:"time_control,omitempty"`
+	// Time left on each side's clock in milliseconds, keyed by mark number. Only set when there is a time control, in
+	// which case the deadline is when the side to move runs out of time.
+	Clocks map[int32]int64 `protobuf:"bytes,18,rep,name=clocks,proto3" json:"clocks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Time given back to a side's clock after each of its moves, in milliseconds.
+	IncrementMs int64 `protobuf:"varint,19,opt,name=increment_ms,json=incrementMs,proto3" json:"increment_ms,omitempty"`
+	// How the increment is given back.
+	IncrementMode IncrementMode `protobuf:"varint,20,opt,name=increment_mode,json=incrementMode,proto3,enum=api.IncrementMode" json:"increment_mode,omitempty"`
 }
 
-func (x *RpcFindMatchResponse) Reset() {
-	*x = RpcFindMatchResponse{}
+func (x *Start) Reset() {
+	*x = Start{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xoxoapi_proto_msgTypes[5]
+		mi := &file_xoxoapi_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RpcFindMatchResponse) String() string {
+func (x *Start) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RpcFindMatchResponse) ProtoMessage() {}
+func (*Start) ProtoMessage() {}
 
-func (x *RpcFindMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_xoxoapi_proto_msgTypes[5]
+func (x *Start) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,131 +1109,6161 @@ func (x *RpcFindMatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RpcFindMatchResponse.ProtoReflect.Descriptor instead.
-func (*RpcFindMatchResponse) Descriptor() ([]byte, []int) {
-	return file_xoxoapi_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Start.ProtoReflect.Descriptor instead.
+func (*Start) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RpcFindMatchResponse) GetMatchIds() []string {
+func (x *Start) GetBoard() []Mark {
 	if x != nil {
-		return x.MatchIds
+		return x.Board
 	}
 	return nil
 }
 
-var File_xoxoapi_proto protoreflect.FileDescriptor
+func (x *Start) GetMarks() map[string]Mark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
 
-var file_xoxoapi_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x78, 0x6f, 0x78, 0x6f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x22, 0xd5, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f,
-	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x2b, 0x0a, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x04,
-	0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x06,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x06,
-	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
-	0x29, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x22, 0x22, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x46, 0x69, 0x6e,
-	0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x61, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x61,
-	0x69, 0x22, 0x33, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x49, 0x64, 0x73, 0x2a, 0x34, 0x0a, 0x04, 0x4d, 0x61, 0x72, 0x6b, 0x12, 0x14,
-	0x0a, 0x10, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x58, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4f, 0x10, 0x02, 0x2a, 0xac, 0x01, 0x0a,
-	0x06, 0x4f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44,
-	0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x4d, 0x4f, 0x56, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4f,
-	0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x45, 0x46, 0x54, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x41, 0x49, 0x10, 0x07, 0x42, 0x33, 0x5a, 0x31, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x65, 0x72, 0x6f, 0x69, 0x63,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6e, 0x61, 0x6b, 0x61, 0x6d, 0x61, 0x2d, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Start) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
 }
 
-var (
-	file_xoxoapi_proto_rawDescOnce sync.Once
-	file_xoxoapi_proto_rawDescData = file_xoxoapi_proto_rawDesc
-)
+func (x *Start) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
 
-func file_xoxoapi_proto_rawDescGZIP() []byte {
-	file_xoxoapi_proto_rawDescOnce.Do(func() {
-		file_xoxoapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_xoxoapi_proto_rawDescData)
-	})
-	return file_xoxoapi_proto_rawDescData
+func (x *Start) GetCosmetics() map[string]*CosmeticSelection {
+	if x != nil {
+		return x.Cosmetics
+	}
+	return nil
 }
 
-var file_xoxoapi_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_xoxoapi_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_xoxoapi_proto_goTypes = []interface{}{
-	(Mark)(0),                    // 0: api.Mark
-	(OpCode)(0),                  // 1: api.OpCode
-	(*Start)(nil),                // 2: api.Start
-	(*Update)(nil),               // 3: api.Update
-	(*Done)(nil),                 // 4: api.Done
-	(*Move)(nil),                 // 5: api.Move
-	(*RpcFindMatchRequest)(nil),  // 6: api.RpcFindMatchRequest
-	(*RpcFindMatchResponse)(nil), // 7: api.RpcFindMatchResponse
-	nil,                          // 8: api.Start.MarksEntry
+func (x *Start) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
 }
-var file_xoxoapi_proto_depIdxs = []int32{
-	0, // 0: api.Start.board:type_name -> api.Mark
-	8, // 1: api.Start.marks:type_name -> api.Start.MarksEntry
-	0, // 2: api.Start.mark:type_name -> api.Mark
-	0, // 3: api.Update.board:type_name -> api.Mark
-	0, // 4: api.Update.mark:type_name -> api.Mark
-	0, // 5: api.Done.board:type_name -> api.Mark
-	0, // 6: api.Done.winner:type_name -> api.Mark
-	0, // 7: api.Start.MarksEntry.value:type_name -> api.Mark
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+
+func (x *Start) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
 }
 
-func init() { file_xoxoapi_proto_init() }
-func file_xoxoapi_proto_init() {
-	if File_xoxoapi_proto != nil {
-		return
+func (x *Start) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_xoxoapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Start); i {
+	return GameMode_GAME_MODE_CLASSIC
+}
+
+func (x *Start) GetActiveBoard() int32 {
+	if x != nil {
+		return x.ActiveBoard
+	}
+	return 0
+}
+
+func (x *Start) GetSubBoardWinners() []Mark {
+	if x != nil {
+		return x.SubBoardWinners
+	}
+	return nil
+}
+
+func (x *Start) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *Start) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Start) GetShape() *BoardShape {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *Start) GetWinRule() WinRule {
+	if x != nil {
+		return x.WinRule
+	}
+	return WinRule_WIN_RULE_FIRST_LINE
+}
+
+func (x *Start) GetTurnUserId() string {
+	if x != nil {
+		return x.TurnUserId
+	}
+	return ""
+}
+
+func (x *Start) GetRotations() map[int32]*TeamRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+func (x *Start) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+func (x *Start) GetClocks() map[int32]int64 {
+	if x != nil {
+		return x.Clocks
+	}
+	return nil
+}
+
+func (x *Start) GetIncrementMs() int64 {
+	if x != nil {
+		return x.IncrementMs
+	}
+	return 0
+}
+
+func (x *Start) GetIncrementMode() IncrementMode {
+	if x != nil {
+		return x.IncrementMode
+	}
+	return IncrementMode_INCREMENT_MODE_FISCHER
+}
+
+// The players sharing a mark, in the order they take their turns.
+type TeamRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User IDs in turn order.
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *TeamRotation) Reset() {
+	*x = TeamRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRotation) ProtoMessage() {}
+
+func (x *TeamRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRotation.ProtoReflect.Descriptor instead.
+func (*TeamRotation) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TeamRotation) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// A game state update sent by the server to clients.
+type Update struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current state of the board.
+	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
+	// Whose turn it is to play.
+	Mark Mark `protobuf:"varint,2,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// The deadline time by which the player must submit their move, or forfeit.
+	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// The number of the next turn to be played, starting at 1.
+	Turn int32 `protobuf:"varint,4,opt,name=turn,proto3" json:"turn,omitempty"`
+	// Ultimate only: the sub-board the player to move must play in, or -1 if they may play in any open sub-board.
+	ActiveBoard int32 `protobuf:"varint,5,opt,name=active_board,json=activeBoard,proto3" json:"active_board,omitempty"`
+	// Ultimate only: the winner of each sub-board, unspecified while it is undecided or if it was drawn.
+	SubBoardWinners []Mark `protobuf:"varint,6,rep,packed,name=sub_board_winners,json=subBoardWinners,proto3,enum=api.Mark" json:"sub_board_winners,omitempty"`
+	// Numerical only: the digit in each cell, zero if empty.
+	Values []int32 `protobuf:"varint,7,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// The dimensions of the board.
+	Shape *BoardShape `protobuf:"bytes,8,opt,name=shape,proto3" json:"shape,omitempty"`
+	// Places decided so far in an elimination game, keyed by user ID.
+	Placements map[string]int32 `protobuf:"bytes,9,rep,name=placements,proto3" json:"placements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The user ID of the player to move.
+	TurnUserId string `protobuf:"bytes,10,opt,name=turn_user_id,json=turnUserId,proto3" json:"turn_user_id,omitempty"`
+	// Time left on each side's clock in milliseconds, keyed by mark number. Only set when there is a time control.
+	Clocks map[int32]int64 `protobuf:"bytes,11,rep,name=clocks,proto3" json:"clocks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *Update) Reset() {
+	*x = Update{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Update) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Update) ProtoMessage() {}
+
+func (x *Update) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Update.ProtoReflect.Descriptor instead.
+func (*Update) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Update) GetBoard() []Mark {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Update) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *Update) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *Update) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *Update) GetActiveBoard() int32 {
+	if x != nil {
+		return x.ActiveBoard
+	}
+	return 0
+}
+
+func (x *Update) GetSubBoardWinners() []Mark {
+	if x != nil {
+		return x.SubBoardWinners
+	}
+	return nil
+}
+
+func (x *Update) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Update) GetShape() *BoardShape {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *Update) GetPlacements() map[string]int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *Update) GetTurnUserId() string {
+	if x != nil {
+		return x.TurnUserId
+	}
+	return ""
+}
+
+func (x *Update) GetClocks() map[int32]int64 {
+	if x != nil {
+		return x.Clocks
+	}
+	return nil
+}
+
+// Complete game round with winner announcement.
+type Done struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The final state of the board.
+	Board []Mark `protobuf:"varint,1,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
+	// The mark assigned to the player who won, if any. Unspecified if it's a draw. In wild this need not be the mark on
+	// the completed line.
+	Winner Mark `protobuf:"varint,2,opt,name=winner,proto3,enum=api.Mark" json:"winner,omitempty"`
+	// Board positions of the line that decided the game, if any. Used to display the row, column, or diagonal. In
+	// misère this is the line the loser completed. May be empty if it's a draw or the winner is by forfeit. In
+	// ultimate these are sub-board indices.
+	WinnerPositions []int32 `protobuf:"varint,3,rep,packed,name=winner_positions,json=winnerPositions,proto3" json:"winner_positions,omitempty"`
+	// Next round start time.
+	NextGameStart int64 `protobuf:"varint,4,opt,name=next_game_start,json=nextGameStart,proto3" json:"next_game_start,omitempty"`
+	// Ultimate only: the winner of each sub-board, unspecified if it was undecided or drawn.
+	SubBoardWinners []Mark `protobuf:"varint,5,rep,packed,name=sub_board_winners,json=subBoardWinners,proto3,enum=api.Mark" json:"sub_board_winners,omitempty"`
+	// The user ID of the winner, if any. Unset in team games, see winner_ids.
+	WinnerId string `protobuf:"bytes,6,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	// The rule variant the game was played with.
+	Variant Variant `protobuf:"varint,7,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// Numerical only: the digit in each cell, zero if empty.
+	Values []int32 `protobuf:"varint,8,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// The dimensions of the board.
+	Shape *BoardShape `protobuf:"bytes,9,opt,name=shape,proto3" json:"shape,omitempty"`
+	// Each player's final place, keyed by user ID, starting at 1. Tied players share a place, and in a draw everyone is
+	// placed first.
+	Placements map[string]int32 `protobuf:"bytes,10,rep,name=placements,proto3" json:"placements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The user IDs of every player on the winning side, if any. More than one in team games.
+	WinnerIds []string `protobuf:"bytes,11,rep,name=winner_ids,json=winnerIds,proto3" json:"winner_ids,omitempty"`
+}
+
+func (x *Done) Reset() {
+	*x = Done{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Done) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Done) ProtoMessage() {}
+
+func (x *Done) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Done.ProtoReflect.Descriptor instead.
+func (*Done) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Done) GetBoard() []Mark {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *Done) GetWinner() Mark {
+	if x != nil {
+		return x.Winner
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *Done) GetWinnerPositions() []int32 {
+	if x != nil {
+		return x.WinnerPositions
+	}
+	return nil
+}
+
+func (x *Done) GetNextGameStart() int64 {
+	if x != nil {
+		return x.NextGameStart
+	}
+	return 0
+}
+
+func (x *Done) GetSubBoardWinners() []Mark {
+	if x != nil {
+		return x.SubBoardWinners
+	}
+	return nil
+}
+
+func (x *Done) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *Done) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *Done) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Done) GetShape() *BoardShape {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *Done) GetPlacements() map[string]int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+func (x *Done) GetWinnerIds() []string {
+	if x != nil {
+		return x.WinnerIds
+	}
+	return nil
+}
+
+// Sent by the server to a player whose message was rejected.
+type Rejected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Why the message was rejected.
+	Reason RejectReason `protobuf:"varint,1,opt,name=reason,proto3,enum=api.RejectReason" json:"reason,omitempty"`
+	// The sequence number of the rejected message: the client's own sequence number for moves that carry one,
+	// otherwise the count of messages the server has received from the player in this match, including the rejected
+	// one.
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *Rejected) Reset() {
+	*x = Rejected{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rejected) ProtoMessage() {}
+
+func (x *Rejected) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rejected.ProtoReflect.Descriptor instead.
+func (*Rejected) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Rejected) GetReason() RejectReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+func (x *Rejected) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// A player intends to make a move.
+type Move struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The position the player wants to place their mark in. In ultimate, positions 0-80 address sub-board
+	// position / 9 and cell position % 9 within it.
+	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// A number chosen by the client to identify the move, increasing with each move it sends. Retrying a move with the
+	// same sequence number is safe, the server acknowledges it again without playing it twice.
+	Sequence int64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The turn the move is meant for, as given by the latest Start or Update. Moves for a turn that has already been
+	// played are rejected as stale. Zero skips the check.
+	Turn int32 `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	// Wild only: the mark to place. Defaults to the player's own mark.
+	Mark Mark `protobuf:"varint,4,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// Numerical only: the digit to place, odd for X and even for O.
+	Value int32 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// The cell to place the mark in, instead of its position. Used for 3D boards.
+	Coordinates *Coordinates `protobuf:"bytes,6,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Move) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Move) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Move) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *Move) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *Move) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Move) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+// Sent by the server to the player whose move was accepted.
+type MoveAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sequence number of the accepted move.
+	Sequence int64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The turn the move was played on.
+	Turn int32 `protobuf:"varint,2,opt,name=turn,proto3" json:"turn,omitempty"`
+}
+
+func (x *MoveAck) Reset() {
+	*x = MoveAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAck) ProtoMessage() {}
+
+func (x *MoveAck) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAck.ProtoReflect.Descriptor instead.
+func (*MoveAck) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveAck) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MoveAck) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+// A chat message or emote. Exactly one of text or emote should be set.
+type Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Free text, at most 120 characters. Filtered by the server before it is relayed.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// A quick emote.
+	Emote Emote `protobuf:"varint,2,opt,name=emote,proto3,enum=api.Emote" json:"emote,omitempty"`
+	// The user ID of the sender. Set by the server when relaying.
+	SenderId string `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+}
+
+func (x *Chat) Reset() {
+	*x = Chat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chat) ProtoMessage() {}
+
+func (x *Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
+func (*Chat) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Chat) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Chat) GetEmote() Emote {
+	if x != nil {
+		return x.Emote
+	}
+	return Emote_EMOTE_UNSPECIFIED
+}
+
+func (x *Chat) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+// A player wishes to mute or unmute another player in the match.
+type Mute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID of the player to mute or unmute.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// True to mute, false to unmute.
+	Muted bool `protobuf:"varint,2,opt,name=muted,proto3" json:"muted,omitempty"`
+}
+
+func (x *Mute) Reset() {
+	*x = Mute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mute) ProtoMessage() {}
+
+func (x *Mute) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mute.ProtoReflect.Descriptor instead.
+func (*Mute) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Mute) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Mute) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+// Payload for an RPC request to find a match.
+type RpcFindMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User can choose a fast or normal speed match.
+	Fast bool `protobuf:"varint,1,opt,name=fast,proto3" json:"fast,omitempty"`
+	// User can choose whether to play with AI
+	Ai bool `protobuf:"varint,2,opt,name=ai,proto3" json:"ai,omitempty"`
+	// Wallet currency each player stakes on the match. Zero for a free match.
+	Stake int64 `protobuf:"varint,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	// The rules to play by.
+	GameMode GameMode `protobuf:"varint,4,opt,name=game_mode,json=gameMode,proto3,enum=api.GameMode" json:"game_mode,omitempty"`
+	// The rule variant to play with.
+	Variant Variant `protobuf:"varint,5,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// Number of players, from 2 to 4. Zero means 2. Matches for more than two players are classic, standard rules
+	// games on a larger board: 5x5 for three players and 6x6 for four, where four in a row completes a line.
+	Players int32 `protobuf:"varint,6,opt,name=players,proto3" json:"players,omitempty"`
+	// How a match with more than two players is decided.
+	WinRule WinRule `protobuf:"varint,7,opt,name=win_rule,json=winRule,proto3,enum=api.WinRule" json:"win_rule,omitempty"`
+	// Play 2v2, with teammates sharing a mark, on the usual board for the mode and variant. Players and win rule must
+	// be left unset.
+	Teams bool `protobuf:"varint,8,opt,name=teams,proto3" json:"teams,omitempty"`
+	// Queue with a realtime party, identified by its party ID. The caller must be a member. A new match is created with
+	// a seat held for every member, who are all told the match ID, and any seats left over are open to other players.
+	PartyId string `protobuf:"bytes,9,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// Where the party's members are placed. Unspecified places them as teammates in team games, opponents otherwise.
+	PartyPlacement PartyPlacement `protobuf:"varint,10,opt,name=party_placement,json=partyPlacement,proto3,enum=api.PartyPlacement" json:"party_placement,omitempty"`
+	// The time control to play with.
+	TimeControl TimeControl `protobuf:"varint,11,opt,name=time_control,json=timeControl,proto3,enum=api.TimeControl" json:"time_control,omitempty"`
+}
+
+func (x *RpcFindMatchRequest) Reset() {
+	*x = RpcFindMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcFindMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcFindMatchRequest) ProtoMessage() {}
+
+func (x *RpcFindMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcFindMatchRequest.ProtoReflect.Descriptor instead.
+func (*RpcFindMatchRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RpcFindMatchRequest) GetFast() bool {
+	if x != nil {
+		return x.Fast
+	}
+	return false
+}
+
+func (x *RpcFindMatchRequest) GetAi() bool {
+	if x != nil {
+		return x.Ai
+	}
+	return false
+}
+
+func (x *RpcFindMatchRequest) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *RpcFindMatchRequest) GetGameMode() GameMode {
+	if x != nil {
+		return x.GameMode
+	}
+	return GameMode_GAME_MODE_CLASSIC
+}
+
+func (x *RpcFindMatchRequest) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *RpcFindMatchRequest) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *RpcFindMatchRequest) GetWinRule() WinRule {
+	if x != nil {
+		return x.WinRule
+	}
+	return WinRule_WIN_RULE_FIRST_LINE
+}
+
+func (x *RpcFindMatchRequest) GetTeams() bool {
+	if x != nil {
+		return x.Teams
+	}
+	return false
+}
+
+func (x *RpcFindMatchRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *RpcFindMatchRequest) GetPartyPlacement() PartyPlacement {
+	if x != nil {
+		return x.PartyPlacement
+	}
+	return PartyPlacement_PARTY_PLACEMENT_UNSPECIFIED
+}
+
+func (x *RpcFindMatchRequest) GetTimeControl() TimeControl {
+	if x != nil {
+		return x.TimeControl
+	}
+	return TimeControl_TIME_CONTROL_UNSPECIFIED
+}
+
+// Payload for an RPC response containing match IDs the user can join.
+type RpcFindMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One or more matches that fit the user's request.
+	MatchIds []string `protobuf:"bytes,1,rep,name=match_ids,json=matchIds,proto3" json:"match_ids,omitempty"`
+}
+
+func (x *RpcFindMatchResponse) Reset() {
+	*x = RpcFindMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcFindMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcFindMatchResponse) ProtoMessage() {}
+
+func (x *RpcFindMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcFindMatchResponse.ProtoReflect.Descriptor instead.
+func (*RpcFindMatchResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RpcFindMatchResponse) GetMatchIds() []string {
+	if x != nil {
+		return x.MatchIds
+	}
+	return nil
+}
+
+// Win, loss and draw totals tracked alongside a player's leaderboard score.
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of games won.
+	Wins int64 `protobuf:"varint,1,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Number of games lost.
+	Losses int64 `protobuf:"varint,2,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Number of games drawn.
+	Draws int64 `protobuf:"varint,3,opt,name=draws,proto3" json:"draws,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerStats) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDraws() int64 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+// A single ranked entry in a leaderboard listing.
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID of the record owner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The username of the record owner.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The current score.
+	Score int64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Rank within the requested scope, starting at 1.
+	Rank int64 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Win, loss and draw totals for the record owner.
+	Stats *PlayerStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Payload for an RPC request to list leaderboard records relative to the caller.
+type RpcLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of records to return.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Group to rank within, when listing a group leaderboard.
+	GroupId string `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// ISO 3166-1 alpha-2 country code to rank within. Defaults to the caller's account location.
+	Country string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (x *RpcLeaderboardRequest) Reset() {
+	*x = RpcLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcLeaderboardRequest) ProtoMessage() {}
+
+func (x *RpcLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*RpcLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RpcLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcLeaderboardRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RpcLeaderboardRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// Payload for an RPC response containing leaderboard records.
+type RpcLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The records in rank order.
+	Records []*LeaderboardEntry `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// The caller's own record, if they have one in this scope.
+	Self *LeaderboardEntry `protobuf:"bytes,2,opt,name=self,proto3" json:"self,omitempty"`
+}
+
+func (x *RpcLeaderboardResponse) Reset() {
+	*x = RpcLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcLeaderboardResponse) ProtoMessage() {}
+
+func (x *RpcLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*RpcLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RpcLeaderboardResponse) GetRecords() []*LeaderboardEntry {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *RpcLeaderboardResponse) GetSelf() *LeaderboardEntry {
+	if x != nil {
+		return x.Self
+	}
+	return nil
+}
+
+// A single change recorded in a user's wallet ledger.
+type WalletLedgerItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ledger item ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The currency amounts added to, or removed from, the wallet.
+	Changeset map[string]int64 `protobuf:"bytes,2,rep,name=changeset,proto3" json:"changeset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// JSON metadata describing the reason for the change.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// When the change was made, in seconds since the Unix epoch.
+	CreateTime int64 `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *WalletLedgerItem) Reset() {
+	*x = WalletLedgerItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletLedgerItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletLedgerItem) ProtoMessage() {}
+
+func (x *WalletLedgerItem) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletLedgerItem.ProtoReflect.Descriptor instead.
+func (*WalletLedgerItem) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WalletLedgerItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WalletLedgerItem) GetChangeset() map[string]int64 {
+	if x != nil {
+		return x.Changeset
+	}
+	return nil
+}
+
+func (x *WalletLedgerItem) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *WalletLedgerItem) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// Payload for an RPC request to list the caller's wallet ledger.
+type RpcWalletLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of ledger items to return.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor from a previous response, to fetch the next page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RpcWalletLedgerRequest) Reset() {
+	*x = RpcWalletLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcWalletLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcWalletLedgerRequest) ProtoMessage() {}
+
+func (x *RpcWalletLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcWalletLedgerRequest.ProtoReflect.Descriptor instead.
+func (*RpcWalletLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RpcWalletLedgerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcWalletLedgerRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Payload for an RPC response containing wallet ledger items.
+type RpcWalletLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ledger items.
+	Items []*WalletLedgerItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Cursor to fetch the next page, if any.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// The caller's current balance.
+	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *RpcWalletLedgerResponse) Reset() {
+	*x = RpcWalletLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcWalletLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcWalletLedgerResponse) ProtoMessage() {}
+
+func (x *RpcWalletLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcWalletLedgerResponse.ProtoReflect.Descriptor instead.
+func (*RpcWalletLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RpcWalletLedgerResponse) GetItems() []*WalletLedgerItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RpcWalletLedgerResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RpcWalletLedgerResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// Payload for an RPC response after claiming the daily login reward.
+type RpcClaimDailyRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of wallet currency granted.
+	Reward int64 `protobuf:"varint,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	// Number of consecutive days the reward has been claimed, including today.
+	Streak int32 `protobuf:"varint,2,opt,name=streak,proto3" json:"streak,omitempty"`
+	// The time from which the next reward can be claimed, in seconds since the Unix epoch.
+	NextClaimTime int64 `protobuf:"varint,3,opt,name=next_claim_time,json=nextClaimTime,proto3" json:"next_claim_time,omitempty"`
+}
+
+func (x *RpcClaimDailyRewardResponse) Reset() {
+	*x = RpcClaimDailyRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcClaimDailyRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcClaimDailyRewardResponse) ProtoMessage() {}
+
+func (x *RpcClaimDailyRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcClaimDailyRewardResponse.ProtoReflect.Descriptor instead.
+func (*RpcClaimDailyRewardResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RpcClaimDailyRewardResponse) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *RpcClaimDailyRewardResponse) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *RpcClaimDailyRewardResponse) GetNextClaimTime() int64 {
+	if x != nil {
+		return x.NextClaimTime
+	}
+	return 0
+}
+
+// An achievement and the caller's progress towards it.
+type Achievement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The achievement ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// What the player must do to unlock it.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// True if the player has unlocked it.
+	Unlocked bool `protobuf:"varint,4,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// When it was unlocked, in seconds since the Unix epoch. Zero if still locked.
+	UnlockTime int64 `protobuf:"varint,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// Progress towards the goal, for achievements that count repeated events.
+	Progress int64 `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	// The progress needed to unlock it.
+	Goal int64 `protobuf:"varint,7,opt,name=goal,proto3" json:"goal,omitempty"`
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Achievement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockTime() int64 {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return 0
+}
+
+func (x *Achievement) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Achievement) GetGoal() int64 {
+	if x != nil {
+		return x.Goal
+	}
+	return 0
+}
+
+// Payload for an RPC response listing every achievement.
+type RpcListAchievementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All achievements, locked and unlocked.
+	Achievements []*Achievement `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+}
+
+func (x *RpcListAchievementsResponse) Reset() {
+	*x = RpcListAchievementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcListAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcListAchievementsResponse) ProtoMessage() {}
+
+func (x *RpcListAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcListAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*RpcListAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RpcListAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+// The cosmetic items a player has equipped.
+type CosmeticSelection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Item ID of the equipped mark skin.
+	MarkSkin string `protobuf:"bytes,1,opt,name=mark_skin,json=markSkin,proto3" json:"mark_skin,omitempty"`
+	// Item ID of the equipped board theme.
+	BoardTheme string `protobuf:"bytes,2,opt,name=board_theme,json=boardTheme,proto3" json:"board_theme,omitempty"`
+}
+
+func (x *CosmeticSelection) Reset() {
+	*x = CosmeticSelection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmeticSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmeticSelection) ProtoMessage() {}
+
+func (x *CosmeticSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmeticSelection.ProtoReflect.Descriptor instead.
+func (*CosmeticSelection) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CosmeticSelection) GetMarkSkin() string {
+	if x != nil {
+		return x.MarkSkin
+	}
+	return ""
+}
+
+func (x *CosmeticSelection) GetBoardTheme() string {
+	if x != nil {
+		return x.BoardTheme
+	}
+	return ""
+}
+
+// An item in the cosmetic catalog.
+type CosmeticItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The item ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What the item changes.
+	Type CosmeticType `protobuf:"varint,2,opt,name=type,proto3,enum=api.CosmeticType" json:"type,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Price in wallet currency. Zero if it cannot be bought.
+	Price int64 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// The achievement that grants this item, if any.
+	AchievementId string `protobuf:"bytes,5,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	// True if the caller owns the item.
+	Owned bool `protobuf:"varint,6,opt,name=owned,proto3" json:"owned,omitempty"`
+	// True if the caller has the item equipped.
+	Equipped bool `protobuf:"varint,7,opt,name=equipped,proto3" json:"equipped,omitempty"`
+}
+
+func (x *CosmeticItem) Reset() {
+	*x = CosmeticItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmeticItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmeticItem) ProtoMessage() {}
+
+func (x *CosmeticItem) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmeticItem.ProtoReflect.Descriptor instead.
+func (*CosmeticItem) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CosmeticItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CosmeticItem) GetType() CosmeticType {
+	if x != nil {
+		return x.Type
+	}
+	return CosmeticType_COSMETIC_TYPE_UNSPECIFIED
+}
+
+func (x *CosmeticItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CosmeticItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CosmeticItem) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *CosmeticItem) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+func (x *CosmeticItem) GetEquipped() bool {
+	if x != nil {
+		return x.Equipped
+	}
+	return false
+}
+
+// Payload for an RPC request to purchase or equip a cosmetic item.
+type RpcCosmeticRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The item ID.
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *RpcCosmeticRequest) Reset() {
+	*x = RpcCosmeticRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcCosmeticRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcCosmeticRequest) ProtoMessage() {}
+
+func (x *RpcCosmeticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcCosmeticRequest.ProtoReflect.Descriptor instead.
+func (*RpcCosmeticRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RpcCosmeticRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+// Payload for an RPC response containing the cosmetic catalog and the caller's inventory.
+type RpcCosmeticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Every item in the catalog.
+	Items []*CosmeticItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// The caller's equipped items.
+	Equipped *CosmeticSelection `protobuf:"bytes,2,opt,name=equipped,proto3" json:"equipped,omitempty"`
+	// The caller's current balance.
+	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *RpcCosmeticsResponse) Reset() {
+	*x = RpcCosmeticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcCosmeticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcCosmeticsResponse) ProtoMessage() {}
+
+func (x *RpcCosmeticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcCosmeticsResponse.ProtoReflect.Descriptor instead.
+func (*RpcCosmeticsResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RpcCosmeticsResponse) GetItems() []*CosmeticItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RpcCosmeticsResponse) GetEquipped() *CosmeticSelection {
+	if x != nil {
+		return x.Equipped
+	}
+	return nil
+}
+
+func (x *RpcCosmeticsResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// A message from a match's chat channel.
+type MatchChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The message ID.
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// The user ID of the sender. Empty for messages posted by the server.
+	SenderId string `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// The username of the sender.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The JSON message content.
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// When the message was sent, in seconds since the Unix epoch.
+	CreateTime int64 `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *MatchChatMessage) Reset() {
+	*x = MatchChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchChatMessage) ProtoMessage() {}
+
+func (x *MatchChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchChatMessage.ProtoReflect.Descriptor instead.
+func (*MatchChatMessage) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MatchChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MatchChatMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *MatchChatMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MatchChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MatchChatMessage) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+// Payload for an RPC request to read a match's chat history.
+type RpcMatchChatHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match whose history to read.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Maximum number of messages to return.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor from a previous response, to fetch another page.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// True to list oldest messages first.
+	Forward bool `protobuf:"varint,4,opt,name=forward,proto3" json:"forward,omitempty"`
+}
+
+func (x *RpcMatchChatHistoryRequest) Reset() {
+	*x = RpcMatchChatHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcMatchChatHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcMatchChatHistoryRequest) ProtoMessage() {}
+
+func (x *RpcMatchChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcMatchChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RpcMatchChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RpcMatchChatHistoryRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RpcMatchChatHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcMatchChatHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RpcMatchChatHistoryRequest) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+// Payload for an RPC response containing a page of a match's chat history.
+type RpcMatchChatHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The messages in the requested order.
+	Messages []*MatchChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// Cursor to fetch the next page, if any.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Cursor to fetch the previous page, if any.
+	PrevCursor string `protobuf:"bytes,3,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+}
+
+func (x *RpcMatchChatHistoryResponse) Reset() {
+	*x = RpcMatchChatHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcMatchChatHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcMatchChatHistoryResponse) ProtoMessage() {}
+
+func (x *RpcMatchChatHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcMatchChatHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RpcMatchChatHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RpcMatchChatHistoryResponse) GetMessages() []*MatchChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *RpcMatchChatHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *RpcMatchChatHistoryResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+// An operator announcement sent to everyone in a match.
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The announcement text.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Announcement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Notice that the server is shutting down. Any game in progress is abandoned without a result.
+type Shutdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seconds until the match ends.
+	GraceSeconds int32 `protobuf:"varint,1,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"`
+	// The time the match will end.
+	Deadline int64 `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// True if the abandoned game was saved and can be resumed later.
+	Resumable bool `protobuf:"varint,3,opt,name=resumable,proto3" json:"resumable,omitempty"`
+}
+
+func (x *Shutdown) Reset() {
+	*x = Shutdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shutdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shutdown) ProtoMessage() {}
+
+func (x *Shutdown) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shutdown.ProtoReflect.Descriptor instead.
+func (*Shutdown) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Shutdown) GetGraceSeconds() int32 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+func (x *Shutdown) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *Shutdown) GetResumable() bool {
+	if x != nil {
+		return x.Resumable
+	}
+	return false
+}
+
+// An operator command signalled to a running match.
+type AdminSignal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The command to run.
+	Command AdminCommand `protobuf:"varint,1,opt,name=command,proto3,enum=api.AdminCommand" json:"command,omitempty"`
+	// The declared winner when force ending a game. Unspecified for a draw.
+	Winner Mark `protobuf:"varint,2,opt,name=winner,proto3,enum=api.Mark" json:"winner,omitempty"`
+	// The player to kick.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The announcement text.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AdminSignal) Reset() {
+	*x = AdminSignal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignal) ProtoMessage() {}
+
+func (x *AdminSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignal.ProtoReflect.Descriptor instead.
+func (*AdminSignal) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AdminSignal) GetCommand() AdminCommand {
+	if x != nil {
+		return x.Command
+	}
+	return AdminCommand_ADMIN_COMMAND_UNSPECIFIED
+}
+
+func (x *AdminSignal) GetWinner() Mark {
+	if x != nil {
+		return x.Winner
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *AdminSignal) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminSignal) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A player in a match, as seen by an operator.
+type MatchPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The mark assigned in the current game, if any.
+	Mark Mark `protobuf:"varint,3,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// False while the player is disconnected but may still return.
+	Connected bool `protobuf:"varint,4,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *MatchPlayer) Reset() {
+	*x = MatchPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchPlayer) ProtoMessage() {}
+
+func (x *MatchPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchPlayer.ProtoReflect.Descriptor instead.
+func (*MatchPlayer) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MatchPlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MatchPlayer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MatchPlayer) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *MatchPlayer) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+// A point in time view of a running match, as seen by an operator.
+type MatchSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match ID.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// The match label, as JSON.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// 1 if the match is open to new players.
+	Open int32 `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	// 1 for a fast match.
+	Fast int32 `protobuf:"varint,4,opt,name=fast,proto3" json:"fast,omitempty"`
+	// Wallet currency each player stakes on a game.
+	Stake int64 `protobuf:"varint,5,opt,name=stake,proto3" json:"stake,omitempty"`
+	// The players in the match.
+	Players []*MatchPlayer `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	// True while a game is in progress.
+	Playing bool `protobuf:"varint,7,opt,name=playing,proto3" json:"playing,omitempty"`
+	// The current state of the board.
+	Board []Mark `protobuf:"varint,8,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
+	// Whose turn it is to play.
+	Mark Mark `protobuf:"varint,9,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// Ticks left for the current player to move.
+	DeadlineRemainingTicks int64 `protobuf:"varint,10,opt,name=deadline_remaining_ticks,json=deadlineRemainingTicks,proto3" json:"deadline_remaining_ticks,omitempty"`
+	// Number of games started in this match.
+	GameNumber int32 `protobuf:"varint,11,opt,name=game_number,json=gameNumber,proto3" json:"game_number,omitempty"`
+	// The match tick the snapshot was taken at.
+	Tick int64 `protobuf:"varint,12,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *MatchSnapshot) Reset() {
+	*x = MatchSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchSnapshot) ProtoMessage() {}
+
+func (x *MatchSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchSnapshot.ProtoReflect.Descriptor instead.
+func (*MatchSnapshot) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MatchSnapshot) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchSnapshot) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MatchSnapshot) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *MatchSnapshot) GetFast() int32 {
+	if x != nil {
+		return x.Fast
+	}
+	return 0
+}
+
+func (x *MatchSnapshot) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *MatchSnapshot) GetPlayers() []*MatchPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *MatchSnapshot) GetPlaying() bool {
+	if x != nil {
+		return x.Playing
+	}
+	return false
+}
+
+func (x *MatchSnapshot) GetBoard() []Mark {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *MatchSnapshot) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *MatchSnapshot) GetDeadlineRemainingTicks() int64 {
+	if x != nil {
+		return x.DeadlineRemainingTicks
+	}
+	return 0
+}
+
+func (x *MatchSnapshot) GetGameNumber() int32 {
+	if x != nil {
+		return x.GameNumber
+	}
+	return 0
+}
+
+func (x *MatchSnapshot) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// Payload for an RPC response listing running matches.
+type RpcAdminListMatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The running matches.
+	Matches []*MatchSnapshot `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// True if there were more running matches than could be listed.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *RpcAdminListMatchesResponse) Reset() {
+	*x = RpcAdminListMatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminListMatchesResponse) ProtoMessage() {}
+
+func (x *RpcAdminListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*RpcAdminListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RpcAdminListMatchesResponse) GetMatches() []*MatchSnapshot {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *RpcAdminListMatchesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Payload for an RPC request acting on a single match.
+type RpcAdminMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match to act on.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// The declared winner when force ending a game. Unspecified for a draw.
+	Winner Mark `protobuf:"varint,2,opt,name=winner,proto3,enum=api.Mark" json:"winner,omitempty"`
+	// The player to kick.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RpcAdminMatchRequest) Reset() {
+	*x = RpcAdminMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminMatchRequest) ProtoMessage() {}
+
+func (x *RpcAdminMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminMatchRequest.ProtoReflect.Descriptor instead.
+func (*RpcAdminMatchRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RpcAdminMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RpcAdminMatchRequest) GetWinner() Mark {
+	if x != nil {
+		return x.Winner
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *RpcAdminMatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Payload for an RPC request to send an announcement to every running match.
+type RpcAdminAnnounceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The announcement text.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RpcAdminAnnounceRequest) Reset() {
+	*x = RpcAdminAnnounceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminAnnounceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminAnnounceRequest) ProtoMessage() {}
+
+func (x *RpcAdminAnnounceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminAnnounceRequest.ProtoReflect.Descriptor instead.
+func (*RpcAdminAnnounceRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RpcAdminAnnounceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Payload for an RPC response to an announcement.
+type RpcAdminAnnounceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of matches the announcement was delivered to.
+	Matches int32 `protobuf:"varint,1,opt,name=matches,proto3" json:"matches,omitempty"`
+	// True if there were more running matches than could be listed, so some did not receive the announcement.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *RpcAdminAnnounceResponse) Reset() {
+	*x = RpcAdminAnnounceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminAnnounceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminAnnounceResponse) ProtoMessage() {}
+
+func (x *RpcAdminAnnounceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminAnnounceResponse.ProtoReflect.Descriptor instead.
+func (*RpcAdminAnnounceResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RpcAdminAnnounceResponse) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *RpcAdminAnnounceResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Payload for an RPC request to resume a game that was interrupted by a server restart.
+type RpcResumeMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match the game was being played in.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (x *RpcResumeMatchRequest) Reset() {
+	*x = RpcResumeMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcResumeMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcResumeMatchRequest) ProtoMessage() {}
+
+func (x *RpcResumeMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcResumeMatchRequest.ProtoReflect.Descriptor instead.
+func (*RpcResumeMatchRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RpcResumeMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+// Payload for an RPC response to resuming a game.
+type RpcResumeMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new match both players should join to carry on.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (x *RpcResumeMatchResponse) Reset() {
+	*x = RpcResumeMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcResumeMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcResumeMatchResponse) ProtoMessage() {}
+
+func (x *RpcResumeMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcResumeMatchResponse.ProtoReflect.Descriptor instead.
+func (*RpcResumeMatchResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RpcResumeMatchResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+// Payload for an RPC response to discarding saved games that were never resumed.
+type RpcExpireGameSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The saved games that were discarded.
+	Expired int32 `protobuf:"varint,1,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *RpcExpireGameSnapshotsResponse) Reset() {
+	*x = RpcExpireGameSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcExpireGameSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcExpireGameSnapshotsResponse) ProtoMessage() {}
+
+func (x *RpcExpireGameSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcExpireGameSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*RpcExpireGameSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RpcExpireGameSnapshotsResponse) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+// A finished game in a player's match history.
+type MatchHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match the game was played in.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// The opponent's user ID.
+	OpponentId string `protobuf:"bytes,2,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// The opponent's username.
+	OpponentUsername string `protobuf:"bytes,3,opt,name=opponent_username,json=opponentUsername,proto3" json:"opponent_username,omitempty"`
+	// The mark the player played as.
+	Mark Mark `protobuf:"varint,4,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// The result for the player.
+	Result GameResult `protobuf:"varint,5,opt,name=result,proto3,enum=api.GameResult" json:"result,omitempty"`
+	// True if the game was decided by a player running out of time.
+	Forfeit bool `protobuf:"varint,6,opt,name=forfeit,proto3" json:"forfeit,omitempty"`
+	// Deprecated: the game speed on entries recorded before the speed field. Read speed instead.
+	//
+	// Deprecated: Marked as deprecated in xoxoapi.proto.
+	Mode string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Wallet currency each player staked on the game.
+	Stake int64 `protobuf:"varint,8,opt,name=stake,proto3" json:"stake,omitempty"`
+	// When the game started, in seconds since the Unix epoch.
+	StartTime int64 `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When the game ended, in seconds since the Unix epoch.
+	EndTime int64 `protobuf:"varint,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How long the game lasted, in milliseconds.
+	DurationMs int64 `protobuf:"varint,11,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// The change the game made to the player's leaderboard score, 0 if it was not counted.
+	RatingDelta int64 `protobuf:"varint,12,opt,name=rating_delta,json=ratingDelta,proto3" json:"rating_delta,omitempty"`
+	// Key of the game's replay in the match_replays storage collection.
+	ReplayKey string `protobuf:"bytes,13,opt,name=replay_key,json=replayKey,proto3" json:"replay_key,omitempty"`
+	// The rules the game was played by.
+	GameMode GameMode `protobuf:"varint,14,opt,name=game_mode,json=gameMode,proto3,enum=api.GameMode" json:"game_mode,omitempty"`
+	// The rule variant the game was played with.
+	Variant Variant `protobuf:"varint,15,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// The player's final place, starting at 1.
+	Placement int32 `protobuf:"varint,16,opt,name=placement,proto3" json:"placement,omitempty"`
+	// Number of players in the game.
+	Players int32 `protobuf:"varint,17,opt,name=players,proto3" json:"players,omitempty"`
+	// The user IDs of every opponent. The opponent fields above are only set when there was a single opponent.
+	OpponentIds []string `protobuf:"bytes,18,rep,name=opponent_ids,json=opponentIds,proto3" json:"opponent_ids,omitempty"`
+	// The user IDs of the player's teammates, in team games.
+	TeammateIds []string `protobuf:"bytes,19,rep,name=teammate_ids,json=teammateIds,proto3" json:"teammate_ids,omitempty"`
+	// The game speed, "normal" or "fast".
+	Speed string `protobuf:"bytes,20,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *MatchHistoryEntry) Reset() {
+	*x = MatchHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchHistoryEntry) ProtoMessage() {}
+
+func (x *MatchHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchHistoryEntry.ProtoReflect.Descriptor instead.
+func (*MatchHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MatchHistoryEntry) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchHistoryEntry) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *MatchHistoryEntry) GetOpponentUsername() string {
+	if x != nil {
+		return x.OpponentUsername
+	}
+	return ""
+}
+
+func (x *MatchHistoryEntry) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *MatchHistoryEntry) GetResult() GameResult {
+	if x != nil {
+		return x.Result
+	}
+	return GameResult_GAME_RESULT_UNSPECIFIED
+}
+
+func (x *MatchHistoryEntry) GetForfeit() bool {
+	if x != nil {
+		return x.Forfeit
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in xoxoapi.proto.
+func (x *MatchHistoryEntry) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MatchHistoryEntry) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetRatingDelta() int64 {
+	if x != nil {
+		return x.RatingDelta
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetReplayKey() string {
+	if x != nil {
+		return x.ReplayKey
+	}
+	return ""
+}
+
+func (x *MatchHistoryEntry) GetGameMode() GameMode {
+	if x != nil {
+		return x.GameMode
+	}
+	return GameMode_GAME_MODE_CLASSIC
+}
+
+func (x *MatchHistoryEntry) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *MatchHistoryEntry) GetPlacement() int32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *MatchHistoryEntry) GetOpponentIds() []string {
+	if x != nil {
+		return x.OpponentIds
+	}
+	return nil
+}
+
+func (x *MatchHistoryEntry) GetTeammateIds() []string {
+	if x != nil {
+		return x.TeammateIds
+	}
+	return nil
+}
+
+func (x *MatchHistoryEntry) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+// Totals of every game a player has finished against one opponent.
+type HeadToHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The opponent's user ID.
+	OpponentId string `protobuf:"bytes,1,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// Games won against the opponent.
+	Wins int64 `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Games lost against the opponent.
+	Losses int64 `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Games drawn against the opponent.
+	Draws int64 `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+}
+
+func (x *HeadToHead) Reset() {
+	*x = HeadToHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadToHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHead) ProtoMessage() {}
+
+func (x *HeadToHead) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHead.ProtoReflect.Descriptor instead.
+func (*HeadToHead) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *HeadToHead) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *HeadToHead) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetDraws() int64 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+// Payload for an RPC request to list the caller's match history, most recent first.
+type RpcMatchHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of entries to return.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor from a previous response, to fetch another page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Only include games against this opponent. Also returns head-to-head totals against them.
+	OpponentId string `protobuf:"bytes,3,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// Only include games at this speed, "normal" or "fast".
+	Speed string `protobuf:"bytes,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Only include games with this result.
+	Result GameResult `protobuf:"varint,5,opt,name=result,proto3,enum=api.GameResult" json:"result,omitempty"`
+	// Only include games played by one of these rules. Empty for any.
+	GameModes []GameMode `protobuf:"varint,6,rep,packed,name=game_modes,json=gameModes,proto3,enum=api.GameMode" json:"game_modes,omitempty"`
+	// Only include games played with one of these rule variants. Empty for any.
+	Variants []Variant `protobuf:"varint,7,rep,packed,name=variants,proto3,enum=api.Variant" json:"variants,omitempty"`
+}
+
+func (x *RpcMatchHistoryRequest) Reset() {
+	*x = RpcMatchHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcMatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcMatchHistoryRequest) ProtoMessage() {}
+
+func (x *RpcMatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcMatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RpcMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RpcMatchHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcMatchHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RpcMatchHistoryRequest) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *RpcMatchHistoryRequest) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+func (x *RpcMatchHistoryRequest) GetResult() GameResult {
+	if x != nil {
+		return x.Result
+	}
+	return GameResult_GAME_RESULT_UNSPECIFIED
+}
+
+func (x *RpcMatchHistoryRequest) GetGameModes() []GameMode {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+func (x *RpcMatchHistoryRequest) GetVariants() []Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// Payload for an RPC response containing a page of the caller's match history.
+type RpcMatchHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The matching entries, most recent first.
+	Entries []*MatchHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Cursor to fetch the next page, if any.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Totals against the requested opponent, if one was given.
+	HeadToHead *HeadToHead `protobuf:"bytes,3,opt,name=head_to_head,json=headToHead,proto3" json:"head_to_head,omitempty"`
+}
+
+func (x *RpcMatchHistoryResponse) Reset() {
+	*x = RpcMatchHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcMatchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcMatchHistoryResponse) ProtoMessage() {}
+
+func (x *RpcMatchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcMatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RpcMatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RpcMatchHistoryResponse) GetEntries() []*MatchHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *RpcMatchHistoryResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RpcMatchHistoryResponse) GetHeadToHead() *HeadToHead {
+	if x != nil {
+		return x.HeadToHead
+	}
+	return nil
+}
+
+// Analysis of a single move against perfect play.
+type MoveAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The move number, starting at 1.
+	MoveNumber int32 `protobuf:"varint,1,opt,name=move_number,json=moveNumber,proto3" json:"move_number,omitempty"`
+	// The player who made the move.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The mark that was placed.
+	Mark Mark `protobuf:"varint,3,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// The position played.
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// How the move compares to perfect play.
+	Quality MoveQuality `protobuf:"varint,5,opt,name=quality,proto3,enum=api.MoveQuality" json:"quality,omitempty"`
+	// Every position that would have kept the best result available.
+	BestPositions []int32 `protobuf:"varint,6,rep,packed,name=best_positions,json=bestPositions,proto3" json:"best_positions,omitempty"`
+	// True if the player had a forced win before the move and no longer does after it.
+	MissedWin bool `protobuf:"varint,7,opt,name=missed_win,json=missedWin,proto3" json:"missed_win,omitempty"`
+	// Time the player took to make the move, in milliseconds.
+	ElapsedMs int64 `protobuf:"varint,8,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+}
+
+func (x *MoveAnalysis) Reset() {
+	*x = MoveAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAnalysis) ProtoMessage() {}
+
+func (x *MoveAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAnalysis.ProtoReflect.Descriptor instead.
+func (*MoveAnalysis) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MoveAnalysis) GetMoveNumber() int32 {
+	if x != nil {
+		return x.MoveNumber
+	}
+	return 0
+}
+
+func (x *MoveAnalysis) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveAnalysis) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *MoveAnalysis) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MoveAnalysis) GetQuality() MoveQuality {
+	if x != nil {
+		return x.Quality
+	}
+	return MoveQuality_MOVE_QUALITY_UNSPECIFIED
+}
+
+func (x *MoveAnalysis) GetBestPositions() []int32 {
+	if x != nil {
+		return x.BestPositions
+	}
+	return nil
+}
+
+func (x *MoveAnalysis) GetMissedWin() bool {
+	if x != nil {
+		return x.MissedWin
+	}
+	return false
+}
+
+func (x *MoveAnalysis) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+// Summary of one player's moves in an analysed game.
+type PlayerAnalysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The player's user ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The mark the player played as.
+	Mark Mark `protobuf:"varint,2,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// Percentage of the player's moves that were best moves.
+	Accuracy float64 `protobuf:"fixed64,3,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	// Number of best moves.
+	Best int32 `protobuf:"varint,4,opt,name=best,proto3" json:"best,omitempty"`
+	// Number of inaccuracies.
+	Inaccuracies int32 `protobuf:"varint,5,opt,name=inaccuracies,proto3" json:"inaccuracies,omitempty"`
+	// Number of blunders.
+	Blunders int32 `protobuf:"varint,6,opt,name=blunders,proto3" json:"blunders,omitempty"`
+	// Number of forced wins missed.
+	MissedWins int32 `protobuf:"varint,7,opt,name=missed_wins,json=missedWins,proto3" json:"missed_wins,omitempty"`
+	// Total time spent on moves, in milliseconds.
+	TotalTimeMs int64 `protobuf:"varint,8,opt,name=total_time_ms,json=totalTimeMs,proto3" json:"total_time_ms,omitempty"`
+	// Average time spent per move, in milliseconds.
+	AverageTimeMs int64 `protobuf:"varint,9,opt,name=average_time_ms,json=averageTimeMs,proto3" json:"average_time_ms,omitempty"`
+}
+
+func (x *PlayerAnalysis) Reset() {
+	*x = PlayerAnalysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAnalysis) ProtoMessage() {}
+
+func (x *PlayerAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAnalysis.ProtoReflect.Descriptor instead.
+func (*PlayerAnalysis) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PlayerAnalysis) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlayerAnalysis) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *PlayerAnalysis) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetBest() int32 {
+	if x != nil {
+		return x.Best
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetInaccuracies() int32 {
+	if x != nil {
+		return x.Inaccuracies
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetBlunders() int32 {
+	if x != nil {
+		return x.Blunders
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetMissedWins() int32 {
+	if x != nil {
+		return x.MissedWins
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetTotalTimeMs() int64 {
+	if x != nil {
+		return x.TotalTimeMs
+	}
+	return 0
+}
+
+func (x *PlayerAnalysis) GetAverageTimeMs() int64 {
+	if x != nil {
+		return x.AverageTimeMs
+	}
+	return 0
+}
+
+// Payload for an RPC request to analyse a finished game.
+type RpcAnalyzeGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match the game was played in.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (x *RpcAnalyzeGameRequest) Reset() {
+	*x = RpcAnalyzeGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAnalyzeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAnalyzeGameRequest) ProtoMessage() {}
+
+func (x *RpcAnalyzeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAnalyzeGameRequest.ProtoReflect.Descriptor instead.
+func (*RpcAnalyzeGameRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RpcAnalyzeGameRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+// Payload for an RPC response containing a post-game analysis.
+type RpcAnalyzeGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The match the game was played in.
+	MatchId string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Each move in the order it was played.
+	Moves []*MoveAnalysis `protobuf:"bytes,2,rep,name=moves,proto3" json:"moves,omitempty"`
+	// A summary for each player.
+	Players []*PlayerAnalysis `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *RpcAnalyzeGameResponse) Reset() {
+	*x = RpcAnalyzeGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAnalyzeGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAnalyzeGameResponse) ProtoMessage() {}
+
+func (x *RpcAnalyzeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAnalyzeGameResponse.ProtoReflect.Descriptor instead.
+func (*RpcAnalyzeGameResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RpcAnalyzeGameResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RpcAnalyzeGameResponse) GetMoves() []*MoveAnalysis {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RpcAnalyzeGameResponse) GetPlayers() []*PlayerAnalysis {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// An account's anti-cheat record, for review by an operator.
+type SuspicionReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Combined suspicion score from 0 to 100.
+	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The heuristics the account currently trips: "perfect_play", "fast_moves", "repeated_pairing" or
+	// "intentional_losing".
+	Flags []string `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+	// Number of games analysed.
+	Games int64 `protobuf:"varint,4,opt,name=games,proto3" json:"games,omitempty"`
+	// Fraction of games played without a single inaccuracy or blunder.
+	PerfectRate float64 `protobuf:"fixed64,5,opt,name=perfect_rate,json=perfectRate,proto3" json:"perfect_rate,omitempty"`
+	// Fraction of moves made faster than a human plausibly could.
+	FastMoveRate float64 `protobuf:"fixed64,6,opt,name=fast_move_rate,json=fastMoveRate,proto3" json:"fast_move_rate,omitempty"`
+	// Fraction of losses in which the account ignored an immediate threat it could have blocked.
+	IgnoredThreatRate float64 `protobuf:"fixed64,7,opt,name=ignored_threat_rate,json=ignoredThreatRate,proto3" json:"ignored_threat_rate,omitempty"`
+	// The opponent the account has played most often recently.
+	TopOpponentId string `protobuf:"bytes,8,opt,name=top_opponent_id,json=topOpponentId,proto3" json:"top_opponent_id,omitempty"`
+	// Number of recent games against that opponent.
+	TopOpponentGames int64 `protobuf:"varint,9,opt,name=top_opponent_games,json=topOpponentGames,proto3" json:"top_opponent_games,omitempty"`
+	// True if the account is kept off the leaderboards.
+	Excluded bool `protobuf:"varint,10,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	// When the record was last updated, in seconds since the Unix epoch.
+	UpdateTime int64 `protobuf:"varint,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *SuspicionReport) Reset() {
+	*x = SuspicionReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspicionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspicionReport) ProtoMessage() {}
+
+func (x *SuspicionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspicionReport.ProtoReflect.Descriptor instead.
+func (*SuspicionReport) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SuspicionReport) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspicionReport) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *SuspicionReport) GetGames() int64 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetPerfectRate() float64 {
+	if x != nil {
+		return x.PerfectRate
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetFastMoveRate() float64 {
+	if x != nil {
+		return x.FastMoveRate
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetIgnoredThreatRate() float64 {
+	if x != nil {
+		return x.IgnoredThreatRate
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetTopOpponentId() string {
+	if x != nil {
+		return x.TopOpponentId
+	}
+	return ""
+}
+
+func (x *SuspicionReport) GetTopOpponentGames() int64 {
+	if x != nil {
+		return x.TopOpponentGames
+	}
+	return 0
+}
+
+func (x *SuspicionReport) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+func (x *SuspicionReport) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// Payload for an RPC request to list flagged accounts.
+type RpcAdminListFlaggedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of reports to return.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor from a previous response, to fetch another page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RpcAdminListFlaggedRequest) Reset() {
+	*x = RpcAdminListFlaggedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminListFlaggedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminListFlaggedRequest) ProtoMessage() {}
+
+func (x *RpcAdminListFlaggedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminListFlaggedRequest.ProtoReflect.Descriptor instead.
+func (*RpcAdminListFlaggedRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RpcAdminListFlaggedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcAdminListFlaggedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Payload for an RPC response containing a page of flagged accounts.
+type RpcAdminListFlaggedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The flagged accounts.
+	Reports []*SuspicionReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Cursor to fetch the next page, if any.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RpcAdminListFlaggedResponse) Reset() {
+	*x = RpcAdminListFlaggedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminListFlaggedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminListFlaggedResponse) ProtoMessage() {}
+
+func (x *RpcAdminListFlaggedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminListFlaggedResponse.ProtoReflect.Descriptor instead.
+func (*RpcAdminListFlaggedResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RpcAdminListFlaggedResponse) GetReports() []*SuspicionReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *RpcAdminListFlaggedResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Payload for an RPC request to keep an account off the leaderboards, or allow it back.
+type RpcAdminExcludePlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// True to exclude the account, false to allow it back.
+	Excluded bool `protobuf:"varint,2,opt,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (x *RpcAdminExcludePlayerRequest) Reset() {
+	*x = RpcAdminExcludePlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAdminExcludePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAdminExcludePlayerRequest) ProtoMessage() {}
+
+func (x *RpcAdminExcludePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAdminExcludePlayerRequest.ProtoReflect.Descriptor instead.
+func (*RpcAdminExcludePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RpcAdminExcludePlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RpcAdminExcludePlayerRequest) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+// A correspondence game, played turn by turn through RPCs over days rather than in a realtime match.
+type AsyncGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The game ID.
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The assignments of the marks to players.
+	Marks map[string]Mark `protobuf:"bytes,2,rep,name=marks,proto3" json:"marks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=api.Mark"`
+	// The current state of the board.
+	Board []Mark `protobuf:"varint,3,rep,packed,name=board,proto3,enum=api.Mark" json:"board,omitempty"`
+	// Whose turn it is to play.
+	Mark Mark `protobuf:"varint,4,opt,name=mark,proto3,enum=api.Mark" json:"mark,omitempty"`
+	// The user ID of the player to move.
+	TurnUserId string `protobuf:"bytes,5,opt,name=turn_user_id,json=turnUserId,proto3" json:"turn_user_id,omitempty"`
+	// The number of the next turn to be played, starting at 1.
+	Turn int32 `protobuf:"varint,6,opt,name=turn,proto3" json:"turn,omitempty"`
+	// The deadline time by which the player must submit their move, or forfeit. For an invitation, when it expires.
+	Deadline int64 `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// The rules the game is played by.
+	Mode GameMode `protobuf:"varint,8,opt,name=mode,proto3,enum=api.GameMode" json:"mode,omitempty"`
+	// The rule variant the game is played with.
+	Variant Variant `protobuf:"varint,9,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// The dimensions of the board.
+	Shape *BoardShape `protobuf:"bytes,10,opt,name=shape,proto3" json:"shape,omitempty"`
+	// Hours each player has for every move.
+	MoveTimeHours int32 `protobuf:"varint,11,opt,name=move_time_hours,json=moveTimeHours,proto3" json:"move_time_hours,omitempty"`
+	// True once the game is over. Finished games are no longer listed, see the match history instead.
+	Done bool `protobuf:"varint,12,opt,name=done,proto3" json:"done,omitempty"`
+	// The mark of the player who won a finished game, if any.
+	Winner Mark `protobuf:"varint,13,opt,name=winner,proto3,enum=api.Mark" json:"winner,omitempty"`
+	// Board positions of the line that decided a finished game, if any.
+	WinnerPositions []int32 `protobuf:"varint,14,rep,packed,name=winner_positions,json=winnerPositions,proto3" json:"winner_positions,omitempty"`
+	// True if a finished game ended because a player ran out of time.
+	Forfeit bool `protobuf:"varint,15,opt,name=forfeit,proto3" json:"forfeit,omitempty"`
+	// Ultimate only: the sub-board the player to move must play in, or -1 if they may play in any open sub-board.
+	ActiveBoard int32 `protobuf:"varint,16,opt,name=active_board,json=activeBoard,proto3" json:"active_board,omitempty"`
+	// Ultimate only: the winner of each sub-board, unspecified while it is undecided or if it was drawn.
+	SubBoardWinners []Mark `protobuf:"varint,17,rep,packed,name=sub_board_winners,json=subBoardWinners,proto3,enum=api.Mark" json:"sub_board_winners,omitempty"`
+	// Numerical only: the digit in each cell, zero if empty.
+	Values []int32 `protobuf:"varint,18,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// True while the game is an invitation the opponent has yet to accept.
+	Pending bool `protobuf:"varint,19,opt,name=pending,proto3" json:"pending,omitempty"`
+	// The user ID of the player who sent the invitation.
+	InviterId string `protobuf:"bytes,20,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+}
+
+func (x *AsyncGame) Reset() {
+	*x = AsyncGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncGame) ProtoMessage() {}
+
+func (x *AsyncGame) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncGame.ProtoReflect.Descriptor instead.
+func (*AsyncGame) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AsyncGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AsyncGame) GetMarks() map[string]Mark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetBoard() []Mark {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetMark() Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *AsyncGame) GetTurnUserId() string {
+	if x != nil {
+		return x.TurnUserId
+	}
+	return ""
+}
+
+func (x *AsyncGame) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *AsyncGame) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *AsyncGame) GetMode() GameMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GameMode_GAME_MODE_CLASSIC
+}
+
+func (x *AsyncGame) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *AsyncGame) GetShape() *BoardShape {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetMoveTimeHours() int32 {
+	if x != nil {
+		return x.MoveTimeHours
+	}
+	return 0
+}
+
+func (x *AsyncGame) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *AsyncGame) GetWinner() Mark {
+	if x != nil {
+		return x.Winner
+	}
+	return Mark_MARK_UNSPECIFIED
+}
+
+func (x *AsyncGame) GetWinnerPositions() []int32 {
+	if x != nil {
+		return x.WinnerPositions
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetForfeit() bool {
+	if x != nil {
+		return x.Forfeit
+	}
+	return false
+}
+
+func (x *AsyncGame) GetActiveBoard() int32 {
+	if x != nil {
+		return x.ActiveBoard
+	}
+	return 0
+}
+
+func (x *AsyncGame) GetSubBoardWinners() []Mark {
+	if x != nil {
+		return x.SubBoardWinners
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AsyncGame) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *AsyncGame) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+// Payload for an RPC request to invite another player to a correspondence game.
+type RpcCreateAsyncGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user ID of the opponent.
+	OpponentId string `protobuf:"bytes,1,opt,name=opponent_id,json=opponentId,proto3" json:"opponent_id,omitempty"`
+	// The rules to play by.
+	GameMode GameMode `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=api.GameMode" json:"game_mode,omitempty"`
+	// The rule variant to play with.
+	Variant Variant `protobuf:"varint,3,opt,name=variant,proto3,enum=api.Variant" json:"variant,omitempty"`
+	// Hours each player has for every move, from 24 to 72. Defaults to 24.
+	MoveTimeHours int32 `protobuf:"varint,4,opt,name=move_time_hours,json=moveTimeHours,proto3" json:"move_time_hours,omitempty"`
+}
+
+func (x *RpcCreateAsyncGameRequest) Reset() {
+	*x = RpcCreateAsyncGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcCreateAsyncGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcCreateAsyncGameRequest) ProtoMessage() {}
+
+func (x *RpcCreateAsyncGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcCreateAsyncGameRequest.ProtoReflect.Descriptor instead.
+func (*RpcCreateAsyncGameRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RpcCreateAsyncGameRequest) GetOpponentId() string {
+	if x != nil {
+		return x.OpponentId
+	}
+	return ""
+}
+
+func (x *RpcCreateAsyncGameRequest) GetGameMode() GameMode {
+	if x != nil {
+		return x.GameMode
+	}
+	return GameMode_GAME_MODE_CLASSIC
+}
+
+func (x *RpcCreateAsyncGameRequest) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_STANDARD
+}
+
+func (x *RpcCreateAsyncGameRequest) GetMoveTimeHours() int32 {
+	if x != nil {
+		return x.MoveTimeHours
+	}
+	return 0
+}
+
+// Payload for an RPC request to accept or decline an invitation to a correspondence game.
+type RpcAsyncGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The game ID.
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *RpcAsyncGameRequest) Reset() {
+	*x = RpcAsyncGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAsyncGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAsyncGameRequest) ProtoMessage() {}
+
+func (x *RpcAsyncGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAsyncGameRequest.ProtoReflect.Descriptor instead.
+func (*RpcAsyncGameRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RpcAsyncGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// Payload for an RPC request to play a move in a correspondence game.
+type RpcSubmitAsyncMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The game ID.
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// The move, as it would be sent in a realtime match. The sequence number is ignored.
+	Move *Move `protobuf:"bytes,2,opt,name=move,proto3" json:"move,omitempty"`
+}
+
+func (x *RpcSubmitAsyncMoveRequest) Reset() {
+	*x = RpcSubmitAsyncMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcSubmitAsyncMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcSubmitAsyncMoveRequest) ProtoMessage() {}
+
+func (x *RpcSubmitAsyncMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcSubmitAsyncMoveRequest.ProtoReflect.Descriptor instead.
+func (*RpcSubmitAsyncMoveRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RpcSubmitAsyncMoveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RpcSubmitAsyncMoveRequest) GetMove() *Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+// Payload for an RPC response carrying a correspondence game.
+type RpcAsyncGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The game, after the move if it was accepted.
+	Game *AsyncGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	// Why the move was rejected, if it was.
+	RejectReason RejectReason `protobuf:"varint,2,opt,name=reject_reason,json=rejectReason,proto3,enum=api.RejectReason" json:"reject_reason,omitempty"`
+}
+
+func (x *RpcAsyncGameResponse) Reset() {
+	*x = RpcAsyncGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcAsyncGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcAsyncGameResponse) ProtoMessage() {}
+
+func (x *RpcAsyncGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcAsyncGameResponse.ProtoReflect.Descriptor instead.
+func (*RpcAsyncGameResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RpcAsyncGameResponse) GetGame() *AsyncGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *RpcAsyncGameResponse) GetRejectReason() RejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+// Payload for an RPC request to list the caller's correspondence games.
+type RpcListAsyncGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of games to return.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor from a previous response, to fetch another page.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RpcListAsyncGamesRequest) Reset() {
+	*x = RpcListAsyncGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcListAsyncGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcListAsyncGamesRequest) ProtoMessage() {}
+
+func (x *RpcListAsyncGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcListAsyncGamesRequest.ProtoReflect.Descriptor instead.
+func (*RpcListAsyncGamesRequest) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RpcListAsyncGamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RpcListAsyncGamesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Payload for an RPC response containing a page of correspondence games.
+type RpcListAsyncGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The caller's games still in progress, and pending invitations.
+	Games []*AsyncGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// Cursor to fetch the next page, if any.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RpcListAsyncGamesResponse) Reset() {
+	*x = RpcListAsyncGamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcListAsyncGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcListAsyncGamesResponse) ProtoMessage() {}
+
+func (x *RpcListAsyncGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcListAsyncGamesResponse.ProtoReflect.Descriptor instead.
+func (*RpcListAsyncGamesResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RpcListAsyncGamesResponse) GetGames() []*AsyncGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *RpcListAsyncGamesResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Payload for an RPC response to expiring stale correspondence games.
+type RpcExpireAsyncGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The games that ended because the player to move ran out of time, and invitations no one accepted in time.
+	Expired int32 `protobuf:"varint,1,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *RpcExpireAsyncGamesResponse) Reset() {
+	*x = RpcExpireAsyncGamesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xoxoapi_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RpcExpireAsyncGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcExpireAsyncGamesResponse) ProtoMessage() {}
+
+func (x *RpcExpireAsyncGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xoxoapi_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcExpireAsyncGamesResponse.ProtoReflect.Descriptor instead.
+func (*RpcExpireAsyncGamesResponse) Descriptor() ([]byte, []int) {
+	return file_xoxoapi_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RpcExpireAsyncGamesResponse) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+var File_xoxoapi_proto protoreflect.FileDescriptor
+
+var file_xoxoapi_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x78, 0x6f, 0x78, 0x6f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x22, 0x52, 0x0a, 0x0a, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x61,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xce, 0x08, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12,
+	0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6f,
+	0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74,
+	0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x6d, 0x65, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x11,
+	0x73, 0x75, 0x62, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x77, 0x69,
+	0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x75, 0x72, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33,
+	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74,
+	0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x0e,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a,
+	0x0b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0c, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x22, 0x9b, 0x04, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x35, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65,
+	0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x75, 0x72, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xf1, 0x03, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x35, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x6e, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x76,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1d, 0x0a,
+	0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x63,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72,
+	0x6e, 0x22, 0x59, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x04,
+	0x4d, 0x75, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75,
+	0x74, 0x65, 0x64, 0x22, 0x8a, 0x03, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x46, 0x69, 0x6e, 0x64, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x61, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x61, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x61, 0x69, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x6e, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x0f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x22, 0x33, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x70, 0x63, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x74, 0x0a, 0x16, 0x52, 0x70, 0x63, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x22, 0xe1, 0x01, 0x0a,
+	0x10, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x46, 0x0a, 0x16, 0x52, 0x70, 0x63, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x78, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x75, 0x0a, 0x1b, 0x52, 0x70, 0x63, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0b, 0x41, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x22, 0x53, 0x0a, 0x1b,
+	0x52, 0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x61,
+	0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x51, 0x0a, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x73,
+	0x6b, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x53,
+	0x6b, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x68, 0x65,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x54,
+	0x68, 0x65, 0x6d, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69,
+	0x63, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74,
+	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x71, 0x75, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22,
+	0x2d, 0x0a, 0x12, 0x52, 0x70, 0x63, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x8d,
+	0x01, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x73,
+	0x6d, 0x65, 0x74, 0x69, 0x63, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x32, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69,
+	0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x65, 0x71, 0x75, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa5,
+	0x01, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x1a, 0x52, 0x70, 0x63, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x1b, 0x52, 0x70, 0x63, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x76, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x0c,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x21,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x7f, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xf3, 0x02, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x61, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x05,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1d, 0x0a,
+	0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x38, 0x0a, 0x18,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x69, 0x0a, 0x1b, 0x52,
+	0x70, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x52, 0x70,
+	0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x32,
+	0x0a, 0x15, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x64, 0x22, 0x33, 0x0a, 0x16, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1e, 0x52, 0x70, 0x63, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0x91, 0x05, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f,
+	0x72, 0x66, 0x65, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6f, 0x72,
+	0x66, 0x65, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x6d, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x54,
+	0x6f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x16, 0x52, 0x70, 0x63,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x17, 0x52, 0x70,
+	0x63, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x31, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x65,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x0e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x62, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x6c, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x77,
+	0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x73, 0x22, 0x32, 0x0a, 0x15, 0x52, 0x70, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x16, 0x52, 0x70, 0x63, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x05, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x22, 0xf8, 0x02, 0x0a, 0x0f, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x66,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x64, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74,
+	0x6f, 0x70, 0x5f, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x74, 0x6f, 0x70, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4a,
+	0x0a, 0x1a, 0x52, 0x70, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x1b, 0x52, 0x70,
+	0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x53, 0x0a, 0x1c, 0x52, 0x70, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0xed, 0x05, 0x0a, 0x09, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1f,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x1d, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x20,
+	0x0a, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x21, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61,
+	0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x21,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66,
+	0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x11, 0x73, 0x75, 0x62,
+	0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52,
+	0x0f, 0x73, 0x75, 0x62, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x1a, 0x43, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x01, 0x0a, 0x19, 0x52, 0x70, 0x63, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x26, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x22, 0x2e, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x53, 0x0a, 0x19, 0x52, 0x70, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x18, 0x52, 0x70,
+	0x63, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x19, 0x52, 0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x37, 0x0a, 0x1b, 0x52, 0x70, 0x63, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x2a, 0x58, 0x0a, 0x04, 0x4d, 0x61, 0x72, 0x6b,
+	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x58,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x4f, 0x10, 0x02, 0x12, 0x11,
+	0x0a, 0x0d, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x54, 0x52, 0x49, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x53, 0x51, 0x55, 0x41, 0x52, 0x45,
+	0x10, 0x04, 0x2a, 0x69, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x53, 0x4d, 0x45, 0x54, 0x49, 0x43, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x53, 0x4d, 0x45, 0x54, 0x49, 0x43, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x53, 0x4b, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x43, 0x4f, 0x53, 0x4d, 0x45, 0x54, 0x49, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x48, 0x45, 0x4d, 0x45, 0x10, 0x02, 0x2a, 0xbf, 0x02,
+	0x0a, 0x06, 0x4f, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f,
+	0x4c, 0x45, 0x46, 0x54, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x41, 0x49, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12,
+	0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45, 0x56, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x48, 0x41, 0x54, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4d, 0x55, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12,
+	0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f,
+	0x57, 0x4e, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d,
+	0x4f, 0x56, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x0e, 0x2a,
+	0x99, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x44,
+	0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x43,
+	0x45, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x4d, 0x49, 0x4e,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x03, 0x12,
+	0x1a, 0x0a, 0x16, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x2a, 0x6a, 0x0a, 0x0a, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x57, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x44, 0x52, 0x41, 0x57, 0x10, 0x03, 0x2a, 0xb8, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x45, 0x58,
+	0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x12,
+	0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x4f, 0x55, 0x52, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x03,
+	0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45,
+	0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x49, 0x45, 0x43,
+	0x45, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x10, 0x08, 0x2a, 0x91, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x48, 0x45, 0x4c,
+	0x4c, 0x4f, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x47, 0x4f,
+	0x4f, 0x44, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4d, 0x4f,
+	0x54, 0x45, 0x5f, 0x57, 0x45, 0x4c, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x4f, 0x50, 0x53, 0x10, 0x04,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x49, 0x4e, 0x4b, 0x49,
+	0x4e, 0x47, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x48,
+	0x41, 0x4e, 0x4b, 0x53, 0x10, 0x06, 0x2a, 0x65, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x43, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4c, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x55, 0x42, 0x45, 0x5f, 0x33, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x55, 0x42, 0x45, 0x5f, 0x34, 0x10, 0x03, 0x2a, 0x3c, 0x0a,
+	0x07, 0x57, 0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x49, 0x4e, 0x5f,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x49, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x4c,
+	0x49, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x75, 0x0a, 0x0b, 0x54,
+	0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x42, 0x55, 0x4c, 0x4c, 0x45, 0x54, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x42, 0x4c, 0x49, 0x54, 0x5a, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x43, 0x41, 0x53, 0x55, 0x41, 0x4c,
+	0x10, 0x03, 0x2a, 0x49, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x49, 0x53, 0x43, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x42, 0x52, 0x4f, 0x4e, 0x53, 0x54, 0x45, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x6f, 0x0a,
+	0x0e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x4d, 0x41, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4f, 0x50, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x2a, 0x5c,
+	0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x41, 0x52,
+	0x49, 0x41, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x45, 0x52,
+	0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x57,
+	0x49, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54,
+	0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x79, 0x0a, 0x0b,
+	0x4d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x4d,
+	0x4f, 0x56, 0x45, 0x5f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x56,
+	0x45, 0x5f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x49, 0x4e, 0x41, 0x43, 0x43, 0x55, 0x52, 0x41, 0x43, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x42, 0x4c,
+	0x55, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x03, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x65, 0x72, 0x6f, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x6e, 0x61, 0x6b, 0x61, 0x6d, 0x61, 0x2d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xoxoapi_proto_rawDescOnce sync.Once
+	file_xoxoapi_proto_rawDescData = file_xoxoapi_proto_rawDesc
+)
+
+func file_xoxoapi_proto_rawDescGZIP() []byte {
+	file_xoxoapi_proto_rawDescOnce.Do(func() {
+		file_xoxoapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_xoxoapi_proto_rawDescData)
+	})
+	return file_xoxoapi_proto_rawDescData
+}
+
+var file_xoxoapi_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_xoxoapi_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_xoxoapi_proto_goTypes = []interface{}{
+	(Mark)(0),                              // 0: api.Mark
+	(CosmeticType)(0),                      // 1: api.CosmeticType
+	(OpCode)(0),                            // 2: api.OpCode
+	(AdminCommand)(0),                      // 3: api.AdminCommand
+	(GameResult)(0),                        // 4: api.GameResult
+	(RejectReason)(0),                      // 5: api.RejectReason
+	(Emote)(0),                             // 6: api.Emote
+	(GameMode)(0),                          // 7: api.GameMode
+	(WinRule)(0),                           // 8: api.WinRule
+	(TimeControl)(0),                       // 9: api.TimeControl
+	(IncrementMode)(0),                     // 10: api.IncrementMode
+	(PartyPlacement)(0),                    // 11: api.PartyPlacement
+	(Variant)(0),                           // 12: api.Variant
+	(MoveQuality)(0),                       // 13: api.MoveQuality
+	(*BoardShape)(nil),                     // 14: api.BoardShape
+	(*Coordinates)(nil),                    // 15: api.Coordinates
+	(*Start)(nil),                          // 16: api.Start
+	(*TeamRotation)(nil),                   // 17: api.TeamRotation
+	(*Update)(nil),                         // 18: api.Update
+	(*Done)(nil),                           // 19: api.Done
+	(*Rejected)(nil),                       // 20: api.Rejected
+	(*Move)(nil),                           // 21: api.Move
+	(*MoveAck)(nil),                        // 22: api.MoveAck
+	(*Chat)(nil),                           // 23: api.Chat
+	(*Mute)(nil),                           // 24: api.Mute
+	(*RpcFindMatchRequest)(nil),            // 25: api.RpcFindMatchRequest
+	(*RpcFindMatchResponse)(nil),           // 26: api.RpcFindMatchResponse
+	(*PlayerStats)(nil),                    // 27: api.PlayerStats
+	(*LeaderboardEntry)(nil),               // 28: api.LeaderboardEntry
+	(*RpcLeaderboardRequest)(nil),          // 29: api.RpcLeaderboardRequest
+	(*RpcLeaderboardResponse)(nil),         // 30: api.RpcLeaderboardResponse
+	(*WalletLedgerItem)(nil),               // 31: api.WalletLedgerItem
+	(*RpcWalletLedgerRequest)(nil),         // 32: api.RpcWalletLedgerRequest
+	(*RpcWalletLedgerResponse)(nil),        // 33: api.RpcWalletLedgerResponse
+	(*RpcClaimDailyRewardResponse)(nil),    // 34: api.RpcClaimDailyRewardResponse
+	(*Achievement)(nil),                    // 35: api.Achievement
+	(*RpcListAchievementsResponse)(nil),    // 36: api.RpcListAchievementsResponse
+	(*CosmeticSelection)(nil),              // 37: api.CosmeticSelection
+	(*CosmeticItem)(nil),                   // 38: api.CosmeticItem
+	(*RpcCosmeticRequest)(nil),             // 39: api.RpcCosmeticRequest
+	(*RpcCosmeticsResponse)(nil),           // 40: api.RpcCosmeticsResponse
+	(*MatchChatMessage)(nil),               // 41: api.MatchChatMessage
+	(*RpcMatchChatHistoryRequest)(nil),     // 42: api.RpcMatchChatHistoryRequest
+	(*RpcMatchChatHistoryResponse)(nil),    // 43: api.RpcMatchChatHistoryResponse
+	(*Announcement)(nil),                   // 44: api.Announcement
+	(*Shutdown)(nil),                       // 45: api.Shutdown
+	(*AdminSignal)(nil),                    // 46: api.AdminSignal
+	(*MatchPlayer)(nil),                    // 47: api.MatchPlayer
+	(*MatchSnapshot)(nil),                  // 48: api.MatchSnapshot
+	(*RpcAdminListMatchesResponse)(nil),    // 49: api.RpcAdminListMatchesResponse
+	(*RpcAdminMatchRequest)(nil),           // 50: api.RpcAdminMatchRequest
+	(*RpcAdminAnnounceRequest)(nil),        // 51: api.RpcAdminAnnounceRequest
+	(*RpcAdminAnnounceResponse)(nil),       // 52: api.RpcAdminAnnounceResponse
+	(*RpcResumeMatchRequest)(nil),          // 53: api.RpcResumeMatchRequest
+	(*RpcResumeMatchResponse)(nil),         // 54: api.RpcResumeMatchResponse
+	(*RpcExpireGameSnapshotsResponse)(nil), // 55: api.RpcExpireGameSnapshotsResponse
+	(*MatchHistoryEntry)(nil),              // 56: api.MatchHistoryEntry
+	(*HeadToHead)(nil),                     // 57: api.HeadToHead
+	(*RpcMatchHistoryRequest)(nil),         // 58: api.RpcMatchHistoryRequest
+	(*RpcMatchHistoryResponse)(nil),        // 59: api.RpcMatchHistoryResponse
+	(*MoveAnalysis)(nil),                   // 60: api.MoveAnalysis
+	(*PlayerAnalysis)(nil),                 // 61: api.PlayerAnalysis
+	(*RpcAnalyzeGameRequest)(nil),          // 62: api.RpcAnalyzeGameRequest
+	(*RpcAnalyzeGameResponse)(nil),         // 63: api.RpcAnalyzeGameResponse
+	(*SuspicionReport)(nil),                // 64: api.SuspicionReport
+	(*RpcAdminListFlaggedRequest)(nil),     // 65: api.RpcAdminListFlaggedRequest
+	(*RpcAdminListFlaggedResponse)(nil),    // 66: api.RpcAdminListFlaggedResponse
+	(*RpcAdminExcludePlayerRequest)(nil),   // 67: api.RpcAdminExcludePlayerRequest
+	(*AsyncGame)(nil),                      // 68: api.AsyncGame
+	(*RpcCreateAsyncGameRequest)(nil),      // 69: api.RpcCreateAsyncGameRequest
+	(*RpcAsyncGameRequest)(nil),            // 70: api.RpcAsyncGameRequest
+	(*RpcSubmitAsyncMoveRequest)(nil),      // 71: api.RpcSubmitAsyncMoveRequest
+	(*RpcAsyncGameResponse)(nil),           // 72: api.RpcAsyncGameResponse
+	(*RpcListAsyncGamesRequest)(nil),       // 73: api.RpcListAsyncGamesRequest
+	(*RpcListAsyncGamesResponse)(nil),      // 74: api.RpcListAsyncGamesResponse
+	(*RpcExpireAsyncGamesResponse)(nil),    // 75: api.RpcExpireAsyncGamesResponse
+	nil,                                    // 76: api.Start.MarksEntry
+	nil,                                    // 77: api.Start.CosmeticsEntry
+	nil,                                    // 78: api.Start.RotationsEntry
+	nil,                                    // 79: api.Start.ClocksEntry
+	nil,                                    // 80: api.Update.PlacementsEntry
+	nil,                                    // 81: api.Update.ClocksEntry
+	nil,                                    // 82: api.Done.PlacementsEntry
+	nil,                                    // 83: api.WalletLedgerItem.ChangesetEntry
+	nil,                                    // 84: api.AsyncGame.MarksEntry
+}
+var file_xoxoapi_proto_depIdxs = []int32{
+	0,  // 0: api.Start.board:type_name -> api.Mark
+	76, // 1: api.Start.marks:type_name -> api.Start.MarksEntry
+	0,  // 2: api.Start.mark:type_name -> api.Mark
+	77, // 3: api.Start.cosmetics:type_name -> api.Start.CosmeticsEntry
+	7,  // 4: api.Start.mode:type_name -> api.GameMode
+	0,  // 5: api.Start.sub_board_winners:type_name -> api.Mark
+	12, // 6: api.Start.variant:type_name -> api.Variant
+	14, // 7: api.Start.shape:type_name -> api.BoardShape
+	8,  // 8: api.Start.win_rule:type_name -> api.WinRule
+	78, // 9: api.Start.rotations:type_name -> api.Start.RotationsEntry
+	9,  // 10: api.Start.time_control:type_name -> api.TimeControl
+	79, // 11: api.Start.clocks:type_name -> api.Start.ClocksEntry
+	10, // 12: api.Start.increment_mode:type_name -> api.IncrementMode
+	0,  // 13: api.Update.board:type_name -> api.Mark
+	0,  // 14: api.Update.mark:type_name -> api.Mark
+	0,  // 15: api.Update.sub_board_winners:type_name -> api.Mark
+	14, // 16: api.Update.shape:type_name -> api.BoardShape
+	80, // 17: api.Update.placements:type_name -> api.Update.PlacementsEntry
+	81, // 18: api.Update.clocks:type_name -> api.Update.ClocksEntry
+	0,  // 19: api.Done.board:type_name -> api.Mark
+	0,  // 20: api.Done.winner:type_name -> api.Mark
+	0,  // 21: api.Done.sub_board_winners:type_name -> api.Mark
+	12, // 22: api.Done.variant:type_name -> api.Variant
+	14, // 23: api.Done.shape:type_name -> api.BoardShape
+	82, // 24: api.Done.placements:type_name -> api.Done.PlacementsEntry
+	5,  // 25: api.Rejected.reason:type_name -> api.RejectReason
+	0,  // 26: api.Move.mark:type_name -> api.Mark
+	15, // 27: api.Move.coordinates:type_name -> api.Coordinates
+	6,  // 28: api.Chat.emote:type_name -> api.Emote
+	7,  // 29: api.RpcFindMatchRequest.game_mode:type_name -> api.GameMode
+	12, // 30: api.RpcFindMatchRequest.variant:type_name -> api.Variant
+	8,  // 31: api.RpcFindMatchRequest.win_rule:type_name -> api.WinRule
+	11, // 32: api.RpcFindMatchRequest.party_placement:type_name -> api.PartyPlacement
+	9,  // 33: api.RpcFindMatchRequest.time_control:type_name -> api.TimeControl
+	27, // 34: api.LeaderboardEntry.stats:type_name -> api.PlayerStats
+	28, // 35: api.RpcLeaderboardResponse.records:type_name -> api.LeaderboardEntry
+	28, // 36: api.RpcLeaderboardResponse.self:type_name -> api.LeaderboardEntry
+	83, // 37: api.WalletLedgerItem.changeset:type_name -> api.WalletLedgerItem.ChangesetEntry
+	31, // 38: api.RpcWalletLedgerResponse.items:type_name -> api.WalletLedgerItem
+	35, // 39: api.RpcListAchievementsResponse.achievements:type_name -> api.Achievement
+	1,  // 40: api.CosmeticItem.type:type_name -> api.CosmeticType
+	38, // 41: api.RpcCosmeticsResponse.items:type_name -> api.CosmeticItem
+	37, // 42: api.RpcCosmeticsResponse.equipped:type_name -> api.CosmeticSelection
+	41, // 43: api.RpcMatchChatHistoryResponse.messages:type_name -> api.MatchChatMessage
+	3,  // 44: api.AdminSignal.command:type_name -> api.AdminCommand
+	0,  // 45: api.AdminSignal.winner:type_name -> api.Mark
+	0,  // 46: api.MatchPlayer.mark:type_name -> api.Mark
+	47, // 47: api.MatchSnapshot.players:type_name -> api.MatchPlayer
+	0,  // 48: api.MatchSnapshot.board:type_name -> api.Mark
+	0,  // 49: api.MatchSnapshot.mark:type_name -> api.Mark
+	48, // 50: api.RpcAdminListMatchesResponse.matches:type_name -> api.MatchSnapshot
+	0,  // 51: api.RpcAdminMatchRequest.winner:type_name -> api.Mark
+	0,  // 52: api.MatchHistoryEntry.mark:type_name -> api.Mark
+	4,  // 53: api.MatchHistoryEntry.result:type_name -> api.GameResult
+	7,  // 54: api.MatchHistoryEntry.game_mode:type_name -> api.GameMode
+	12, // 55: api.MatchHistoryEntry.variant:type_name -> api.Variant
+	4,  // 56: api.RpcMatchHistoryRequest.result:type_name -> api.GameResult
+	7,  // 57: api.RpcMatchHistoryRequest.game_modes:type_name -> api.GameMode
+	12, // 58: api.RpcMatchHistoryRequest.variants:type_name -> api.Variant
+	56, // 59: api.RpcMatchHistoryResponse.entries:type_name -> api.MatchHistoryEntry
+	57, // 60: api.RpcMatchHistoryResponse.head_to_head:type_name -> api.HeadToHead
+	0,  // 61: api.MoveAnalysis.mark:type_name -> api.Mark
+	13, // 62: api.MoveAnalysis.quality:type_name -> api.MoveQuality
+	0,  // 63: api.PlayerAnalysis.mark:type_name -> api.Mark
+	60, // 64: api.RpcAnalyzeGameResponse.moves:type_name -> api.MoveAnalysis
+	61, // 65: api.RpcAnalyzeGameResponse.players:type_name -> api.PlayerAnalysis
+	64, // 66: api.RpcAdminListFlaggedResponse.reports:type_name -> api.SuspicionReport
+	84, // 67: api.AsyncGame.marks:type_name -> api.AsyncGame.MarksEntry
+	0,  // 68: api.AsyncGame.board:type_name -> api.Mark
+	0,  // 69: api.AsyncGame.mark:type_name -> api.Mark
+	7,  // 70: api.AsyncGame.mode:type_name -> api.GameMode
+	12, // 71: api.AsyncGame.variant:type_name -> api.Variant
+	14, // 72: api.AsyncGame.shape:type_name -> api.BoardShape
+	0,  // 73: api.AsyncGame.winner:type_name -> api.Mark
+	0,  // 74: api.AsyncGame.sub_board_winners:type_name -> api.Mark
+	7,  // 75: api.RpcCreateAsyncGameRequest.game_mode:type_name -> api.GameMode
+	12, // 76: api.RpcCreateAsyncGameRequest.variant:type_name -> api.Variant
+	21, // 77: api.RpcSubmitAsyncMoveRequest.move:type_name -> api.Move
+	68, // 78: api.RpcAsyncGameResponse.game:type_name -> api.AsyncGame
+	5,  // 79: api.RpcAsyncGameResponse.reject_reason:type_name -> api.RejectReason
+	68, // 80: api.RpcListAsyncGamesResponse.games:type_name -> api.AsyncGame
+	0,  // 81: api.Start.MarksEntry.value:type_name -> api.Mark
+	37, // 82: api.Start.CosmeticsEntry.value:type_name -> api.CosmeticSelection
+	17, // 83: api.Start.RotationsEntry.value:type_name -> api.TeamRotation
+	0,  // 84: api.AsyncGame.MarksEntry.value:type_name -> api.Mark
+	85, // [85:85] is the sub-list for method output_type
+	85, // [85:85] is the sub-list for method input_type
+	85, // [85:85] is the sub-list for extension type_name
+	85, // [85:85] is the sub-list for extension extendee
+	0,  // [0:85] is the sub-list for field type_name
+}
+
+func init() { file_xoxoapi_proto_init() }
+func file_xoxoapi_proto_init() {
+	if File_xoxoapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xoxoapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardShape); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Start); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Done); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rejected); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Move); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcFindMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcFindMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletLedgerItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcWalletLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcWalletLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcClaimDailyRewardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Achievement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcListAchievementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmeticSelection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosmeticItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcCosmeticRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcCosmeticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcMatchChatHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcMatchChatHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shutdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSignal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -661,8 +7274,8 @@ func file_xoxoapi_proto_init() {
 				return nil
 			}
 		}
-		file_xoxoapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update); i {
+		file_xoxoapi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchPlayer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -673,8 +7286,8 @@ func file_xoxoapi_proto_init() {
 				return nil
 			}
 		}
-		file_xoxoapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Done); i {
+		file_xoxoapi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -685,8 +7298,8 @@ func file_xoxoapi_proto_init() {
 				return nil
 			}
 		}
-		file_xoxoapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Move); i {
+		file_xoxoapi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminListMatchesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -697,8 +7310,8 @@ func file_xoxoapi_proto_init() {
 				return nil
 			}
 		}
-		file_xoxoapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RpcFindMatchRequest); i {
+		file_xoxoapi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminMatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -709,8 +7322,296 @@ func file_xoxoapi_proto_init() {
 				return nil
 			}
 		}
-		file_xoxoapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RpcFindMatchResponse); i {
+		file_xoxoapi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminAnnounceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminAnnounceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcResumeMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcResumeMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcExpireGameSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadToHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcMatchHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcMatchHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAnalyzeGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAnalyzeGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspicionReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminListFlaggedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminListFlaggedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAdminExcludePlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsyncGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcCreateAsyncGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAsyncGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcSubmitAsyncMoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcAsyncGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcListAsyncGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcListAsyncGamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xoxoapi_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RpcExpireAsyncGamesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -727,8 +7628,8 @@ func file_xoxoapi_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xoxoapi_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      14,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,730 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Correspondence games in progress, owned by the system and keyed by game ID.
+	asyncGameCollection = "async_games"
+	// Each player's correspondence games in progress, keyed by game ID.
+	asyncGamePlayerCollection = "async_game_players"
+
+	asyncMoveTimeMinHours = 24
+	asyncMoveTimeMaxHours = 72
+
+	// Most games, invitations included, two players may have open against each other at once.
+	asyncMaxGamesPerPair = 3
+
+	asyncListDefaultLimit = 20
+	asyncListMaxLimit     = 100
+	asyncBatchSize        = 100
+)
+
+// A correspondence game in progress, as kept in storage between moves.
+type asyncGame struct {
+	GameID   string                `json:"game_id"`
+	Label    *MatchLabel           `json:"label"`
+	Board    []api.Mark            `json:"board"`
+	Marks    map[string]api.Mark   `json:"marks"`
+	Rotation map[api.Mark][]string `json:"rotation"`
+	Mark     api.Mark              `json:"mark"`
+	Moves    []*moveRecord         `json:"moves"`
+	// Hours each player has for every move.
+	MoveTimeHours int32 `json:"move_time_hours"`
+	// When the game and the current turn began, in seconds since the Unix epoch. For an invitation, when it was sent.
+	StartTime     int64 `json:"start_time"`
+	TurnStartTime int64 `json:"turn_start_time"`
+	// Set while the game is an invitation the opponent has yet to accept. Neither the clock nor the result count until
+	// it is accepted, and it is withdrawn if not accepted within the move time.
+	Pending   bool   `json:"pending,omitempty"`
+	InviterID string `json:"inviter_id,omitempty"`
+}
+
+// A game's entry in one player's list of games.
+type asyncGamePlayer struct {
+	OpponentID string `json:"opponent_id"`
+}
+
+// The game as match state, so it is played by the same rules as a realtime game.
+func (g *asyncGame) state() *MatchState {
+	return &MatchState{
+		label:         g.Label,
+		presences:     make(map[string]runtime.Presence),
+		playing:       true,
+		board:         g.Board,
+		moves:         g.Moves,
+		marks:         g.Marks,
+		rotation:      g.Rotation,
+		mark:          g.Mark,
+		placements:    make(map[api.Mark]int32, len(g.Marks)),
+		gameStartedAt: time.Unix(g.StartTime, 0),
+		turnStartedAt: time.Unix(g.TurnStartTime, 0),
+	}
+}
+
+// When the player to move runs out of time.
+func (g *asyncGame) deadline() time.Time {
+	return time.Unix(g.TurnStartTime, 0).Add(time.Duration(g.MoveTimeHours) * time.Hour)
+}
+
+// The game as sent to clients, with s the game's current match state.
+func (g *asyncGame) toApi(s *MatchState) *api.AsyncGame {
+	game := &api.AsyncGame{
+		GameId:          g.GameID,
+		Marks:           s.marks,
+		Board:           s.board,
+		Mark:            s.mark,
+		Turn:            s.turn(),
+		Mode:            api.GameMode(s.label.Mode),
+		Variant:         api.Variant(s.label.Variant),
+		Shape:           rulesFor(s.label).shape(),
+		MoveTimeHours:   g.MoveTimeHours,
+		Done:            !s.playing,
+		Winner:          s.winner,
+		WinnerPositions: s.winnerPositions,
+		Forfeit:         s.forfeit,
+		Pending:         g.Pending,
+		InviterId:       g.InviterID,
+	}
+	if g.Pending {
+		game.Deadline = g.deadline().Unix()
+	} else if s.playing {
+		game.TurnUserId = s.currentPlayer()
+		game.Deadline = g.deadline().Unix()
+	}
+	if game.Mode == api.GameMode_GAME_MODE_ULTIMATE {
+		if s.playing {
+			game.ActiveBoard, game.SubBoardWinners = ultimateState(s.board, s.moves)
+		} else {
+			game.SubBoardWinners = ultimateSubBoardWinners(s.board)
+		}
+	}
+	if game.Variant == api.Variant_VARIANT_NUMERICAL {
+		game.Values = numericalValues(len(s.board), s.moves)
+	}
+	return game
+}
+
+func newAsyncGameID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func readAsyncGame(ctx context.Context, nk runtime.NakamaModule, gameID string) (*asyncGame, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: asyncGameCollection,
+			Key:        gameID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(objects) == 0 {
+		return nil, "", errGameNotFound
+	}
+	game := &asyncGame{}
+	if err := json.Unmarshal([]byte(objects[0].Value), game); err != nil {
+		return nil, "", err
+	}
+	return game, objects[0].Version, nil
+}
+
+// Save the game, failing if it has changed since it was read at the given version. Use "*" for a new game, which also
+// adds it to each player's list of games.
+func writeAsyncGame(ctx context.Context, nk runtime.NakamaModule, game *asyncGame, version string) error {
+	value, err := json.Marshal(game)
+	if err != nil {
+		return err
+	}
+	writes := []*runtime.StorageWrite{
+		{
+			Collection:      asyncGameCollection,
+			Key:             game.GameID,
+			Value:           string(value),
+			Version:         version,
+			PermissionRead:  0, // Only server can read
+			PermissionWrite: 0, // Only server can write
+		},
+	}
+	if version == "*" {
+		for userID := range game.Marks {
+			entry := &asyncGamePlayer{}
+			for opponentID := range game.Marks {
+				if opponentID != userID {
+					entry.OpponentID = opponentID
+				}
+			}
+			value, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			writes = append(writes, &runtime.StorageWrite{
+				Collection:      asyncGamePlayerCollection,
+				Key:             game.GameID,
+				UserID:          userID,
+				Value:           string(value),
+				PermissionRead:  1, // Owner read
+				PermissionWrite: 0, // Only server can write
+			})
+		}
+	}
+	_, err = nk.StorageWrite(ctx, writes)
+	return err
+}
+
+// Count the games, invitations included, a player has open against an opponent.
+func countAsyncGames(ctx context.Context, nk runtime.NakamaModule, userID, opponentID string) (int, error) {
+	var count int
+	cursor := ""
+	for {
+		objects, next, err := nk.StorageList(ctx, "", userID, asyncGamePlayerCollection, asyncBatchSize, cursor)
+		if err != nil {
+			return 0, err
+		}
+		for _, object := range objects {
+			entry := &asyncGamePlayer{}
+			if err := json.Unmarshal([]byte(object.Value), entry); err != nil {
+				return 0, err
+			}
+			if entry.OpponentID == opponentID {
+				count++
+			}
+		}
+		if next == "" {
+			return count, nil
+		}
+		cursor = next
+	}
+}
+
+// Take a game out of storage and off each player's list of games. Reports false if someone else changed the game
+// first.
+func removeAsyncGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, game *asyncGame, version string) bool {
+	deletes := []*runtime.StorageDelete{
+		{
+			Collection: asyncGameCollection,
+			Key:        game.GameID,
+			Version:    version,
+		},
+	}
+	if err := nk.StorageDelete(ctx, deletes); err != nil {
+		logger.Warn("async game %v changed before it could be removed: %v", game.GameID, err)
+		return false
+	}
+
+	deletes = deletes[:0]
+	for userID := range game.Marks {
+		deletes = append(deletes, &runtime.StorageDelete{
+			Collection: asyncGamePlayerCollection,
+			Key:        game.GameID,
+			UserID:     userID,
+		})
+	}
+	if err := nk.StorageDelete(ctx, deletes); err != nil {
+		logger.Error("error removing async game %v from player lists: %v", game.GameID, err)
+	}
+	return true
+}
+
+// Take a finished game out of storage and record its result as for any other game. Reports false if someone else
+// changed the game first.
+func finishAsyncGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions, game *asyncGame, version string, s *MatchState) bool {
+	if !removeAsyncGame(ctx, logger, nk, game, version) {
+		return false
+	}
+
+	settlePlacements(s)
+	logger.Info("async game %v ended with result %v", game.GameID, gameResult(s))
+	usernames := make(map[string]string, len(s.marks))
+	userIDs := make([]string, 0, len(s.marks))
+	for userID := range s.marks {
+		userIDs = append(userIDs, userID)
+	}
+	if users, err := nk.UsersGetId(ctx, userIDs, nil); err != nil {
+		logger.Error("error reading player accounts: %v", err)
+	} else {
+		for _, user := range users {
+			usernames[user.Id] = user.Username
+		}
+	}
+	outcomes := gameOutcomes(s)
+	ratingDeltas := make(map[string]int64, len(s.marks))
+	for userID := range s.marks {
+		ratingDeltas[userID] = setLeaderboard(ctx, nk, logger, userID, usernames[userID], placementScore(s, userID), outcomes[userID])
+	}
+	// Anti-cheat timing heuristics are left out, moves made days apart tell them nothing.
+	writeMatchHistory(ctx, logger, nk, marshaler, unmarshaler, game.GameID, s, ratingDeltas)
+	nk.MetricsCounterAdd(metricGameResults, labelTagsWith(s.label, "result", gameResult(s)), 1)
+	evaluateAchievements(ctx, logger, nk, nil, marshaler, s)
+
+	notifications := make([]*runtime.NotificationSend, 0, len(s.marks))
+	for userID := range s.marks {
+		placement, _ := s.placement(userID)
+		notifications = append(notifications, &runtime.NotificationSend{
+			UserID:  userID,
+			Subject: "Your game is over",
+			Content: map[string]interface{}{
+				"game_id":   game.GameID,
+				"placement": placement,
+				"forfeit":   s.forfeit,
+			},
+			Code:       notificationCodeAsyncGameOver,
+			Persistent: true,
+		})
+	}
+	if err := nk.NotificationsSend(ctx, notifications); err != nil {
+		logger.Error("error sending async game over notifications: %v", err)
+	}
+	return true
+}
+
+// End the game if the player to move has run out of time, or withdraw an invitation no one accepted in time, reporting
+// whether it did.
+func expireAsyncGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions, game *asyncGame, version string, s *MatchState, t time.Time) bool {
+	if t.Before(game.deadline()) {
+		return false
+	}
+	if game.Pending {
+		s.playing = false
+		return removeAsyncGame(ctx, logger, nk, game, version)
+	}
+	if !s.timeOut(t) {
+		// Only two sides play correspondence games, so the other side is left as the winner.
+		logger.Warn("async game %v still has sides to play after a time out", game.GameID)
+	}
+	s.playing = false
+	s.forfeit = true
+	return finishAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, version, s)
+}
+
+// Tell the player to move that it's their turn.
+func notifyAsyncTurn(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, game *asyncGame, s *MatchState, senderID string) {
+	content := map[string]interface{}{
+		"game_id":  game.GameID,
+		"turn":     s.turn(),
+		"deadline": game.deadline().Unix(),
+	}
+	if err := nk.NotificationSend(ctx, s.currentPlayer(), "It's your turn", content, notificationCodeAsyncTurn, senderID, true); err != nil {
+		logger.Error("error sending async turn notification: %v", err)
+	}
+}
+
+// Invite another player to a correspondence game. The game starts once they accept.
+func rpcCreateAsyncGame(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcCreateAsyncGameRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.OpponentId == "" || request.OpponentId == userID {
+			return "", errInvalidInput
+		}
+		if _, ok := api.GameMode_name[int32(request.GameMode)]; !ok {
+			return "", errInvalidInput
+		}
+		if _, ok := api.Variant_name[int32(request.Variant)]; !ok || !variantAllowed(request.GameMode, request.Variant) {
+			return "", errInvalidInput
+		}
+		if request.MoveTimeHours == 0 {
+			request.MoveTimeHours = asyncMoveTimeMinHours
+		}
+		if request.MoveTimeHours < asyncMoveTimeMinHours || request.MoveTimeHours > asyncMoveTimeMaxHours {
+			return "", errInvalidInput
+		}
+
+		users, err := nk.UsersGetId(ctx, []string{request.OpponentId}, nil)
+		if err != nil {
+			logger.Error("error reading opponent account: %v", err)
+			return "", errInternalError
+		}
+		if len(users) == 0 {
+			return "", errInvalidInput
+		}
+
+		count, err := countAsyncGames(ctx, nk, userID, request.OpponentId)
+		if err != nil {
+			logger.Error("error counting async games: %v", err)
+			return "", errInternalError
+		}
+		if count >= asyncMaxGamesPerPair {
+			return "", errTooManyGames
+		}
+
+		gameID, err := newAsyncGameID()
+		if err != nil {
+			logger.Error("error generating async game ID: %v", err)
+			return "", errInternalError
+		}
+
+		t := time.Now().UTC()
+		s := &MatchState{
+			random: mathrand.New(mathrand.NewSource(t.UnixNano())),
+			label: &MatchLabel{
+				Mode:     int(request.GameMode),
+				Variant:  int(request.Variant),
+				Players:  minPlayers,
+				TeamSize: 1,
+			},
+			presences: map[string]runtime.Presence{userID: nil, request.OpponentId: nil},
+		}
+		s.assignMarks()
+		game := &asyncGame{
+			GameID:        gameID,
+			Label:         s.label,
+			Board:         newBoard(rulesFor(s.label).shape()),
+			Marks:         s.marks,
+			Rotation:      s.rotation,
+			Mark:          api.Mark_MARK_X,
+			MoveTimeHours: request.MoveTimeHours,
+			StartTime:     t.Unix(),
+			TurnStartTime: t.Unix(),
+			Pending:       true,
+			InviterID:     userID,
+		}
+		if err := writeAsyncGame(ctx, nk, game, "*"); err != nil {
+			logger.Error("error writing async game: %v", err)
+			return "", errInternalError
+		}
+
+		content := map[string]interface{}{
+			"game_id":         game.GameID,
+			"mode":            request.GameMode,
+			"variant":         request.Variant,
+			"move_time_hours": game.MoveTimeHours,
+			"deadline":        game.deadline().Unix(),
+		}
+		if err := nk.NotificationSend(ctx, request.OpponentId, "You've been invited to a game", content, notificationCodeAsyncInvite, userID, true); err != nil {
+			logger.Error("error sending async invite notification: %v", err)
+		}
+
+		s = game.state()
+
+		buf, err := marshaler.Marshal(&api.RpcAsyncGameResponse{Game: game.toApi(s)})
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Accept an invitation to a correspondence game, which starts the game and the first player's clock.
+func rpcAcceptAsyncGame(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcAsyncGameRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.GameId == "" {
+			return "", errInvalidInput
+		}
+
+		game, version, err := readAsyncGame(ctx, nk, request.GameId)
+		if err == errGameNotFound {
+			return "", err
+		}
+		if err != nil {
+			logger.Error("error reading async game: %v", err)
+			return "", errInternalError
+		}
+		if _, ok := game.Marks[userID]; !ok {
+			return "", errGameNotFound
+		}
+		if !game.Pending || game.InviterID == userID {
+			return "", errInvalidInput
+		}
+
+		t := time.Now().UTC()
+		if expireAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, version, game.state(), t) {
+			return "", errGameNotFound
+		}
+
+		game.Pending = false
+		game.StartTime = t.Unix()
+		game.TurnStartTime = t.Unix()
+		if err := writeAsyncGame(ctx, nk, game, version); err != nil {
+			logger.Warn("async game %v changed before it could be accepted: %v", game.GameID, err)
+			return "", errGameChanged
+		}
+
+		s := game.state()
+		nk.MetricsCounterAdd(metricGamesStarted, labelTagsWith(s.label, "async", "true"), 1)
+		if s.currentPlayer() != userID {
+			notifyAsyncTurn(ctx, logger, nk, game, s, userID)
+		}
+
+		buf, err := marshaler.Marshal(&api.RpcAsyncGameResponse{Game: game.toApi(s)})
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Decline an invitation to a correspondence game, or withdraw one the caller sent. Nothing is recorded for the game.
+func rpcDeclineAsyncGame(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcAsyncGameRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.GameId == "" {
+			return "", errInvalidInput
+		}
+
+		game, version, err := readAsyncGame(ctx, nk, request.GameId)
+		if err == errGameNotFound {
+			return "", err
+		}
+		if err != nil {
+			logger.Error("error reading async game: %v", err)
+			return "", errInternalError
+		}
+		if _, ok := game.Marks[userID]; !ok {
+			return "", errGameNotFound
+		}
+		if !game.Pending {
+			return "", errInvalidInput
+		}
+		if !removeAsyncGame(ctx, logger, nk, game, version) {
+			return "", errGameChanged
+		}
+
+		s := game.state()
+		s.playing = false
+		buf, err := marshaler.Marshal(&api.RpcAsyncGameResponse{Game: game.toApi(s)})
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Play a move in a correspondence game, checked and applied by the same rules as a move in a realtime match.
+func rpcSubmitAsyncMove(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcSubmitAsyncMoveRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.GameId == "" || request.Move == nil {
+			return "", errInvalidInput
+		}
+
+		game, version, err := readAsyncGame(ctx, nk, request.GameId)
+		if err == errGameNotFound {
+			return "", err
+		}
+		if err != nil {
+			logger.Error("error reading async game: %v", err)
+			return "", errInternalError
+		}
+		mark, ok := game.Marks[userID]
+		if !ok {
+			return "", errGameNotFound
+		}
+		if game.Pending {
+			return "", errGameNotStarted
+		}
+
+		t := time.Now().UTC()
+		s := game.state()
+		msg := request.Move
+		response := &api.RpcAsyncGameResponse{}
+		rules := rulesFor(s.label)
+		move := newMoveRecord(rules, userID, mark, msg)
+		move.Sequence = 0
+		switch {
+		case expireAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, version, s, t):
+			response.RejectReason = api.RejectReason_REJECT_REASON_OUT_OF_TIME
+		case !s.playing:
+			// The game ran out of time, but someone else finished it first.
+			return "", errGameChanged
+		case msg.Turn != 0 && msg.Turn != s.turn():
+			response.RejectReason = api.RejectReason_REJECT_REASON_STALE_MOVE
+		case s.mark != mark || userID != s.currentPlayer():
+			response.RejectReason = api.RejectReason_REJECT_REASON_NOT_YOUR_TURN
+		default:
+			response.RejectReason = rules.checkMove(s.board, s.moves, move)
+		}
+
+		if response.RejectReason == api.RejectReason_REJECT_REASON_UNSPECIFIED {
+			move.ElapsedMs = t.Sub(s.turnStartedAt).Milliseconds()
+			if over := s.playMove(rules, move); over {
+				if !finishAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, version, s) {
+					return "", errGameChanged
+				}
+			} else {
+				game.Board, game.Moves, game.Mark = s.board, s.moves, s.mark
+				game.TurnStartTime = t.Unix()
+				if err := writeAsyncGame(ctx, nk, game, version); err != nil {
+					logger.Warn("async game %v changed before the move could be saved: %v", game.GameID, err)
+					return "", errGameChanged
+				}
+				s.turnStartedAt = t
+				notifyAsyncTurn(ctx, logger, nk, game, s, userID)
+			}
+		} else {
+			logger.Debug("async move rejected: %v", response.RejectReason)
+		}
+
+		response.Game = game.toApi(s)
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// List the caller's correspondence games in progress and pending invitations. Games found to have run out of time are
+// ended, and invitations withdrawn, instead.
+func rpcListAsyncGames(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcListAsyncGamesRequest{}
+		if payload != "" {
+			if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+				return "", errUnmarshal
+			}
+		}
+
+		limit := int(request.Limit)
+		if limit <= 0 {
+			limit = asyncListDefaultLimit
+		} else if limit > asyncListMaxLimit {
+			limit = asyncListMaxLimit
+		}
+
+		objects, cursor, err := nk.StorageList(ctx, "", userID, asyncGamePlayerCollection, limit, request.Cursor)
+		if err != nil {
+			logger.Error("error listing async games: %v", err)
+			return "", errInternalError
+		}
+
+		t := time.Now().UTC()
+		response := &api.RpcListAsyncGamesResponse{
+			Games:  make([]*api.AsyncGame, 0, len(objects)),
+			Cursor: cursor,
+		}
+		for _, object := range objects {
+			game, version, err := readAsyncGame(ctx, nk, object.Key)
+			if err == errGameNotFound {
+				continue
+			}
+			if err != nil {
+				logger.Error("error reading async game %v: %v", object.Key, err)
+				continue
+			}
+			s := game.state()
+			if expireAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, version, s, t) {
+				continue
+			}
+			response.Games = append(response.Games, game.toApi(s))
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// End every correspondence game whose player to move has run out of time. Meant to be called on a schedule.
+func rpcAdminExpireAsyncGames(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		t := time.Now().UTC()
+		response := &api.RpcExpireAsyncGamesResponse{}
+		cursor := ""
+		for {
+			objects, next, err := nk.StorageList(ctx, "", "", asyncGameCollection, asyncBatchSize, cursor)
+			if err != nil {
+				logger.Error("error listing async games: %v", err)
+				return "", errInternalError
+			}
+			for _, object := range objects {
+				game := &asyncGame{}
+				if err := json.Unmarshal([]byte(object.Value), game); err != nil {
+					logger.Warn("error decoding async game %v: %v", object.Key, err)
+					continue
+				}
+				if expireAsyncGame(ctx, logger, nk, marshaler, unmarshaler, game, object.Version, game.state(), t) {
+					response.Expired++
+				}
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
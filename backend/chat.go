@@ -0,0 +1,162 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+const (
+	chatMaxLength = 120
+
+	// Each player may send at most this many chat messages or emotes within the window.
+	chatRateLimitMessages  = 3
+	chatRateLimitWindowSec = 5
+)
+
+// Words replaced with asterisks before a chat message is relayed, matched case-insensitively as word prefixes.
+var chatProfanityPattern = regexp.MustCompile(`(?i)\b(fuck|shit|bitch|bastard|asshole|cunt|dick|piss|slut|whore)\w*`)
+
+// Relay a chat message or emote from one player to everyone else in the match who has not muted them, or for team
+// chat only to their teammates.
+func (m *MatchHandler) handleChat(logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, message runtime.MatchData, teamOnly bool) {
+	senderID := message.GetUserId()
+
+	msg := &api.Chat{}
+	if err := m.unmarshaler.Unmarshal(message.GetData(), msg); err != nil {
+		// Client sent bad data.
+		m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_INVALID_MESSAGE)
+		m.strike(logger, dispatcher, tick, s, message)
+		return
+	}
+
+	text := strings.TrimSpace(msg.Text)
+	if _, ok := api.Emote_name[int32(msg.Emote)]; !ok || (text == "") == (msg.Emote == api.Emote_EMOTE_UNSPECIFIED) || utf8.RuneCountInString(text) > chatMaxLength {
+		// Client sent an unknown emote, both or neither of text and emote, or text that is too long.
+		m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_INVALID_MESSAGE)
+		return
+	}
+
+	if !s.allowChat(senderID, tick) {
+		logger.Debug("chat rate limited for %v", senderID)
+		m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_RATE_LIMITED)
+		return
+	}
+
+	recipients := s.chatRecipients(senderID, teamOnly)
+	if len(recipients) == 0 {
+		return
+	}
+
+	buf, err := m.marshaler.Marshal(&api.Chat{
+		Text:     filterProfanity(text),
+		Emote:    msg.Emote,
+		SenderId: senderID,
+	})
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+		return
+	}
+	opCode := api.OpCode_OPCODE_CHAT
+	if teamOnly {
+		opCode = api.OpCode_OPCODE_TEAM_CHAT
+	}
+	_ = dispatcher.BroadcastMessage(int64(opCode), buf, recipients, nil, true)
+}
+
+// Handle the chat and mute messages players may send at any time, whether or not a game is in progress. Reports
+// whether the message was one of them.
+func (m *MatchHandler) handleSocialMessage(logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, message runtime.MatchData) bool {
+	switch api.OpCode(message.GetOpCode()) {
+	case api.OpCode_OPCODE_CHAT:
+		m.handleChat(logger, dispatcher, tick, s, message, false)
+
+	case api.OpCode_OPCODE_TEAM_CHAT:
+		if s.label.teamSize() < 2 {
+			// Only team games have team chat.
+			logger.Debug("message rejected: no teams")
+			m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_UNEXPECTED_OPCODE)
+			return true
+		}
+		m.handleChat(logger, dispatcher, tick, s, message, true)
+
+	case api.OpCode_OPCODE_MUTE:
+		m.handleMute(logger, dispatcher, tick, s, message)
+
+	default:
+		return false
+	}
+	return true
+}
+
+// Record that one player has muted or unmuted another in the match. Mutes last for the lifetime of the match.
+func (m *MatchHandler) handleMute(logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, message runtime.MatchData) {
+	msg := &api.Mute{}
+	if err := m.unmarshaler.Unmarshal(message.GetData(), msg); err != nil || msg.UserId == "" || msg.UserId == message.GetUserId() || !s.inMatch(msg.UserId) {
+		// Client sent bad data, or a user who is not in this match.
+		m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_INVALID_MESSAGE)
+		m.strike(logger, dispatcher, tick, s, message)
+		return
+	}
+
+	muted, ok := s.mutes[message.GetUserId()]
+	if !ok {
+		muted = make(map[string]bool, 1)
+		s.mutes[message.GetUserId()] = muted
+	}
+	if msg.Muted {
+		muted[msg.UserId] = true
+	} else {
+		delete(muted, msg.UserId)
+	}
+	logger.Debug("%v set mute of %v to %v", message.GetUserId(), msg.UserId, msg.Muted)
+}
+
+// Whether the user is in the match, or played in its most recent game.
+func (s *MatchState) inMatch(userID string) bool {
+	if _, ok := s.presences[userID]; ok {
+		return true
+	}
+	_, ok := s.marks[userID]
+	return ok
+}
+
+// Check a player's chat allowance, recording the message if it is allowed.
+func (s *MatchState) allowChat(userID string, tick int64) bool {
+	windowStart := tick - chatRateLimitWindowSec*tickRate
+	recent := s.chatTicks[userID][:0]
+	for _, sent := range s.chatTicks[userID] {
+		if sent > windowStart {
+			recent = append(recent, sent)
+		}
+	}
+	if len(recent) >= chatRateLimitMessages {
+		s.chatTicks[userID] = recent
+		return false
+	}
+	s.chatTicks[userID] = append(recent, tick)
+	return true
+}
+
+func filterProfanity(text string) string {
+	return chatProfanityPattern.ReplaceAllStringFunc(text, func(word string) string {
+		return strings.Repeat("*", utf8.RuneCountInString(word))
+	})
+}
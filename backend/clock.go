@@ -0,0 +1,122 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// The clock settings behind a named time control.
+type timeControl struct {
+	// Time on each side's clock when the game starts.
+	bank time.Duration
+	// Time given back after each move.
+	increment time.Duration
+	// How the increment is given back.
+	mode api.IncrementMode
+}
+
+var timeControls = map[api.TimeControl]timeControl{
+	api.TimeControl_TIME_CONTROL_BULLET: {bank: 15 * time.Second, increment: 1 * time.Second, mode: api.IncrementMode_INCREMENT_MODE_FISCHER},
+	api.TimeControl_TIME_CONTROL_BLITZ:  {bank: 1 * time.Minute, increment: 2 * time.Second, mode: api.IncrementMode_INCREMENT_MODE_FISCHER},
+	api.TimeControl_TIME_CONTROL_CASUAL: {bank: 3 * time.Minute, increment: 5 * time.Second, mode: api.IncrementMode_INCREMENT_MODE_BRONSTEIN},
+}
+
+func timeControlName(control api.TimeControl) string {
+	switch control {
+	case api.TimeControl_TIME_CONTROL_BULLET:
+		return "bullet"
+	case api.TimeControl_TIME_CONTROL_BLITZ:
+		return "blitz"
+	case api.TimeControl_TIME_CONTROL_CASUAL:
+		return "casual"
+	default:
+		return "none"
+	}
+}
+
+// Full clocks for every side, or nil if the match has no time control.
+func newClocks(label *MatchLabel, sides []api.Mark) map[api.Mark]int64 {
+	control, ok := timeControls[api.TimeControl(label.TimeControl)]
+	if !ok {
+		return nil
+	}
+	clocks := make(map[api.Mark]int64, len(sides))
+	for _, mark := range sides {
+		clocks[mark] = control.bank.Milliseconds()
+	}
+	return clocks
+}
+
+// Stop the clock of the side that just moved, taking off the time the move took and giving back the increment.
+func (s *MatchState) pressClock(mark api.Mark, elapsed time.Duration) {
+	if s.clocks == nil {
+		return
+	}
+	control := timeControls[api.TimeControl(s.label.TimeControl)]
+	s.clocks[mark] -= elapsed.Milliseconds()
+	switch control.mode {
+	case api.IncrementMode_INCREMENT_MODE_FISCHER:
+		s.clocks[mark] += control.increment.Milliseconds()
+	case api.IncrementMode_INCREMENT_MODE_BRONSTEIN:
+		s.clocks[mark] += min(elapsed, control.increment).Milliseconds()
+	}
+}
+
+// Time left on each side's clock at the given time, with the side to move's clock running since their turn began.
+func (s *MatchState) clocksAt(t time.Time) map[api.Mark]int64 {
+	if s.clocks == nil {
+		return nil
+	}
+	clocks := make(map[api.Mark]int64, len(s.clocks))
+	for mark, remaining := range s.clocks {
+		clocks[mark] = remaining
+	}
+	if s.playing {
+		clocks[s.mark] = max(0, clocks[s.mark]-t.Sub(s.turnStartedAt).Milliseconds())
+	}
+	return clocks
+}
+
+// Clocks at the given time keyed by mark number, as sent to clients.
+func (s *MatchState) clocksMessage(t time.Time) map[int32]int64 {
+	clocks := s.clocksAt(t)
+	if clocks == nil {
+		return nil
+	}
+	message := make(map[int32]int64, len(clocks))
+	for mark, remaining := range clocks {
+		message[int32(mark)] = remaining
+	}
+	return message
+}
+
+// When the side to move runs out of time.
+func (s *MatchState) deadline(t time.Time) time.Time {
+	if s.clocks != nil {
+		return s.turnStartedAt.Add(time.Duration(s.clocks[s.mark]) * time.Millisecond)
+	}
+	return t.Add(time.Duration(s.deadlineRemainingTicks/tickRate) * time.Second)
+}
+
+// Whether the side to move has run out of time. Without a time control only fast matches enforce the turn deadline.
+func (s *MatchState) flagged(t time.Time) bool {
+	if s.clocks != nil {
+		return !t.Before(s.deadline(t))
+	}
+	return s.deadlineRemainingTicks <= 0 && s.label.Fast == 1
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// A two-player game under the given time control with X to move, their turn having begun at start.
+func testClockState(control api.TimeControl, start time.Time) *MatchState {
+	label := &MatchLabel{TimeControl: int(control)}
+	return &MatchState{
+		label:         label,
+		playing:       true,
+		mark:          api.Mark_MARK_X,
+		clocks:        newClocks(label, []api.Mark{api.Mark_MARK_X, api.Mark_MARK_O}),
+		turnStartedAt: start,
+	}
+}
+
+func TestNewClocks(t *testing.T) {
+	tests := []struct {
+		control api.TimeControl
+		want    map[api.Mark]int64
+	}{
+		{api.TimeControl_TIME_CONTROL_UNSPECIFIED, nil},
+		{api.TimeControl_TIME_CONTROL_BULLET, map[api.Mark]int64{api.Mark_MARK_X: 15000, api.Mark_MARK_O: 15000}},
+		{api.TimeControl_TIME_CONTROL_BLITZ, map[api.Mark]int64{api.Mark_MARK_X: 60000, api.Mark_MARK_O: 60000}},
+		{api.TimeControl_TIME_CONTROL_CASUAL, map[api.Mark]int64{api.Mark_MARK_X: 180000, api.Mark_MARK_O: 180000}},
+	}
+	for _, tt := range tests {
+		t.Run(timeControlName(tt.control), func(t *testing.T) {
+			got := testClockState(tt.control, time.Time{}).clocks
+			if (got == nil) != (tt.want == nil) || !maps.Equal(got, tt.want) {
+				t.Errorf("newClocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPressClock(t *testing.T) {
+	tests := []struct {
+		name    string
+		control api.TimeControl
+		elapsed time.Duration
+		want    int64
+	}{
+		{"fischer adds the full increment", api.TimeControl_TIME_CONTROL_BULLET, 3 * time.Second, 15000 - 3000 + 1000},
+		{"fischer can build up time", api.TimeControl_TIME_CONTROL_BLITZ, 500 * time.Millisecond, 60000 - 500 + 2000},
+		{"fischer on an instant move", api.TimeControl_TIME_CONTROL_BLITZ, 0, 60000 + 2000},
+		{"bronstein gives back a quick move", api.TimeControl_TIME_CONTROL_CASUAL, 3 * time.Second, 180000},
+		{"bronstein gives back the increment at most", api.TimeControl_TIME_CONTROL_CASUAL, 8 * time.Second, 180000 - 8000 + 5000},
+		{"bronstein at exactly the increment", api.TimeControl_TIME_CONTROL_CASUAL, 5 * time.Second, 180000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testClockState(tt.control, time.Time{})
+			other := s.clocks[api.Mark_MARK_O]
+			s.pressClock(api.Mark_MARK_X, tt.elapsed)
+			if got := s.clocks[api.Mark_MARK_X]; got != tt.want {
+				t.Errorf("clock after move = %v, want %v", got, tt.want)
+			}
+			if got := s.clocks[api.Mark_MARK_O]; got != other {
+				t.Errorf("opponent's clock = %v, want %v", got, other)
+			}
+		})
+	}
+	t.Run("no time control", func(t *testing.T) {
+		s := testClockState(api.TimeControl_TIME_CONTROL_UNSPECIFIED, time.Time{})
+		s.pressClock(api.Mark_MARK_X, time.Second)
+		if s.clocks != nil {
+			t.Errorf("clocks = %v, want nil", s.clocks)
+		}
+	})
+}
+
+func TestClocksAt(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name    string
+		playing bool
+		at      time.Duration
+		want    map[api.Mark]int64
+	}{
+		{"side to move's clock runs", true, 4 * time.Second, map[api.Mark]int64{api.Mark_MARK_X: 11000, api.Mark_MARK_O: 15000}},
+		{"clock stops at zero", true, 20 * time.Second, map[api.Mark]int64{api.Mark_MARK_X: 0, api.Mark_MARK_O: 15000}},
+		{"clocks stand between games", false, 4 * time.Second, map[api.Mark]int64{api.Mark_MARK_X: 15000, api.Mark_MARK_O: 15000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testClockState(api.TimeControl_TIME_CONTROL_BULLET, start)
+			s.playing = tt.playing
+			if got := s.clocksAt(start.Add(tt.at)); !maps.Equal(got, tt.want) {
+				t.Errorf("clocksAt() = %v, want %v", got, tt.want)
+			}
+			if s.clocks[api.Mark_MARK_X] != 15000 {
+				t.Errorf("clocksAt() changed the stored clock to %v", s.clocks[api.Mark_MARK_X])
+			}
+		})
+	}
+}
+
+func TestFlagged(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name    string
+		control api.TimeControl
+		fast    int
+		ticks   int64
+		at      time.Duration
+		want    bool
+	}{
+		{"time left on the clock", api.TimeControl_TIME_CONTROL_BULLET, 0, 0, 14999 * time.Millisecond, false},
+		{"clock runs out", api.TimeControl_TIME_CONTROL_BULLET, 0, 0, 15 * time.Second, true},
+		{"clock ignores the turn deadline", api.TimeControl_TIME_CONTROL_BULLET, 1, 0, time.Second, false},
+		{"fast match past the turn deadline", api.TimeControl_TIME_CONTROL_UNSPECIFIED, 1, 0, 0, true},
+		{"fast match before the turn deadline", api.TimeControl_TIME_CONTROL_UNSPECIFIED, 1, 1, 0, false},
+		{"normal match past the turn deadline", api.TimeControl_TIME_CONTROL_UNSPECIFIED, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testClockState(tt.control, start)
+			s.label.Fast = tt.fast
+			s.deadlineRemainingTicks = tt.ticks
+			if got := s.flagged(start.Add(tt.at)); got != tt.want {
+				t.Errorf("flagged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	cosmeticCollection = "cosmetics"
+	cosmeticKey        = "inventory"
+
+	cosmeticDefaultMarkSkin   = "mark_classic"
+	cosmeticDefaultBoardTheme = "board_classic"
+
+	walletReasonCosmeticPurchase = "cosmetic_purchase"
+
+	cosmeticPurchaseAttempts = 3
+)
+
+type cosmeticItem struct {
+	id          string
+	kind        api.CosmeticType
+	name        string
+	price       int64
+	achievement string
+}
+
+// The catalog of cosmetic items. Items with no price can only be obtained through their achievement, or are owned by
+// everyone if they have neither.
+var cosmeticCatalog = []*cosmeticItem{
+	{id: cosmeticDefaultMarkSkin, kind: api.CosmeticType_COSMETIC_TYPE_MARK_SKIN, name: "Classic"},
+	{id: "mark_neon", kind: api.CosmeticType_COSMETIC_TYPE_MARK_SKIN, name: "Neon", price: 500},
+	{id: "mark_chalk", kind: api.CosmeticType_COSMETIC_TYPE_MARK_SKIN, name: "Chalk", price: 750},
+	{id: "mark_gold", kind: api.CosmeticType_COSMETIC_TYPE_MARK_SKIN, name: "Gold", achievement: "win_streak_10"},
+	{id: "mark_circuit", kind: api.CosmeticType_COSMETIC_TYPE_MARK_SKIN, name: "Circuit", price: 1200},
+	{id: cosmeticDefaultBoardTheme, kind: api.CosmeticType_COSMETIC_TYPE_BOARD_THEME, name: "Classic"},
+	{id: "board_wood", kind: api.CosmeticType_COSMETIC_TYPE_BOARD_THEME, name: "Wood", price: 400},
+	{id: "board_space", kind: api.CosmeticType_COSMETIC_TYPE_BOARD_THEME, name: "Space", price: 1000},
+	{id: "board_marble", kind: api.CosmeticType_COSMETIC_TYPE_BOARD_THEME, name: "Marble", achievement: "draws_100"},
+}
+
+// Per-user cosmetic state, kept in storage.
+type cosmeticInventory struct {
+	Owned      map[string]bool `json:"owned"`
+	MarkSkin   string          `json:"mark_skin"`
+	BoardTheme string          `json:"board_theme"`
+}
+
+func cosmeticCatalogItem(id string) *cosmeticItem {
+	for _, item := range cosmeticCatalog {
+		if item.id == id {
+			return item
+		}
+	}
+	return nil
+}
+
+func (i *cosmeticItem) free() bool {
+	return i.price == 0 && i.achievement == ""
+}
+
+func (inv *cosmeticInventory) owns(item *cosmeticItem) bool {
+	return item.free() || inv.Owned[item.id]
+}
+
+func (inv *cosmeticInventory) selection() *api.CosmeticSelection {
+	return &api.CosmeticSelection{
+		MarkSkin:   inv.MarkSkin,
+		BoardTheme: inv.BoardTheme,
+	}
+}
+
+// List the catalog with the caller's owned and equipped items.
+func rpcListCosmetics(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		inventory, _, err := readCosmeticInventory(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading cosmetic inventory: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalCosmeticsResponse(ctx, logger, nk, marshaler, userID, inventory)
+	}
+}
+
+// Buy a catalog item with wallet currency.
+func rpcPurchaseCosmetic(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcCosmeticRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+
+		item := cosmeticCatalogItem(request.ItemId)
+		if item == nil || item.price <= 0 {
+			return "", errInvalidInput
+		}
+
+		// Debit the wallet and add the item in one update, so the player is never charged without receiving it. A
+		// concurrent change to the inventory, such as equipping an item, fails the versioned write and is retried.
+		var inventory *cosmeticInventory
+		for attempt := 0; ; attempt++ {
+			var version string
+			var err error
+			inventory, version, err = readCosmeticInventory(ctx, nk, userID)
+			if err != nil {
+				logger.Error("error reading cosmetic inventory: %v", err)
+				return "", errInternalError
+			}
+			if inventory.owns(item) {
+				return "", errAlreadyOwned
+			}
+
+			inventory.Owned[item.id] = true
+			write, err := cosmeticInventoryWrite(userID, inventory, version)
+			if err != nil {
+				logger.Error("error encoding cosmetic inventory: %v", err)
+				return "", errInternalError
+			}
+			if _, _, err = nk.MultiUpdate(ctx, nil, []*runtime.StorageWrite{write}, nil, []*runtime.WalletUpdate{
+				{
+					UserID:    userID,
+					Changeset: map[string]int64{walletCurrency: -item.price},
+					Metadata:  map[string]interface{}{"reason": walletReasonCosmeticPurchase, "item_id": item.id},
+				},
+			}, true); err == nil {
+				break
+			}
+
+			// The wallet rejects any change that would leave the balance negative.
+			if balance, balanceErr := walletBalance(ctx, nk, userID); balanceErr == nil && balance < item.price {
+				logger.Info("cosmetic purchase rejected: %v", err)
+				return "", errInsufficientFunds
+			}
+			if attempt+1 >= cosmeticPurchaseAttempts {
+				logger.Error("error purchasing cosmetic: %v", err)
+				return "", errInternalError
+			}
+		}
+
+		return marshalCosmeticsResponse(ctx, logger, nk, marshaler, userID, inventory)
+	}
+}
+
+// Equip an owned item in the slot for its type.
+func rpcEquipCosmetic(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcCosmeticRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+
+		item := cosmeticCatalogItem(request.ItemId)
+		if item == nil {
+			return "", errInvalidInput
+		}
+
+		inventory, version, err := readCosmeticInventory(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading cosmetic inventory: %v", err)
+			return "", errInternalError
+		}
+		if !inventory.owns(item) {
+			return "", errNotOwned
+		}
+
+		switch item.kind {
+		case api.CosmeticType_COSMETIC_TYPE_MARK_SKIN:
+			inventory.MarkSkin = item.id
+		case api.CosmeticType_COSMETIC_TYPE_BOARD_THEME:
+			inventory.BoardTheme = item.id
+		}
+
+		if err := writeCosmeticInventory(ctx, nk, userID, inventory, version); err != nil {
+			logger.Error("error writing cosmetic inventory: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalCosmeticsResponse(ctx, logger, nk, marshaler, userID, inventory)
+	}
+}
+
+func marshalCosmeticsResponse(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, userID string, inventory *cosmeticInventory) (string, error) {
+	balance, err := walletBalance(ctx, nk, userID)
+	if err != nil {
+		logger.Error("error reading wallet balance: %v", err)
+		return "", errInternalError
+	}
+
+	response := &api.RpcCosmeticsResponse{
+		Items:    make([]*api.CosmeticItem, 0, len(cosmeticCatalog)),
+		Equipped: inventory.selection(),
+		Balance:  balance,
+	}
+	for _, item := range cosmeticCatalog {
+		response.Items = append(response.Items, &api.CosmeticItem{
+			Id:            item.id,
+			Type:          item.kind,
+			Name:          item.name,
+			Price:         item.price,
+			AchievementId: item.achievement,
+			Owned:         inventory.owns(item),
+			Equipped:      item.id == inventory.MarkSkin || item.id == inventory.BoardTheme,
+		})
+	}
+
+	buf, err := marshaler.Marshal(response)
+	if err != nil {
+		logger.Error("error marshaling response payload: %v", err.Error())
+		return "", errMarshal
+	}
+	return string(buf), nil
+}
+
+// The write giving a user every item granted by the achievements they just unlocked, or nil if they grant none.
+func achievementCosmeticsWrite(ctx context.Context, nk runtime.NakamaModule, userID string, unlocked []*achievementDefinition) (*runtime.StorageWrite, error) {
+	var granted []*cosmeticItem
+	for _, definition := range unlocked {
+		for _, item := range cosmeticCatalog {
+			if item.achievement == definition.id {
+				granted = append(granted, item)
+			}
+		}
+	}
+	if len(granted) == 0 {
+		return nil, nil
+	}
+
+	inventory, version, err := readCosmeticInventory(ctx, nk, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range granted {
+		inventory.Owned[item.id] = true
+	}
+	return cosmeticInventoryWrite(userID, inventory, version)
+}
+
+// Read the equipped cosmetics for several users at once, falling back to the defaults for anyone without an inventory.
+func readCosmeticSelections(ctx context.Context, nk runtime.NakamaModule, userIDs []string) (map[string]*api.CosmeticSelection, error) {
+	reads := make([]*runtime.StorageRead, 0, len(userIDs))
+	for _, userID := range userIDs {
+		reads = append(reads, &runtime.StorageRead{
+			Collection: cosmeticCollection,
+			Key:        cosmeticKey,
+			UserID:     userID,
+		})
+	}
+
+	objects, err := nk.StorageRead(ctx, reads)
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make(map[string]*api.CosmeticSelection, len(userIDs))
+	for _, userID := range userIDs {
+		selections[userID] = newCosmeticInventory().selection()
+	}
+	for _, object := range objects {
+		inventory := newCosmeticInventory()
+		if err := json.Unmarshal([]byte(object.Value), inventory); err != nil {
+			return nil, err
+		}
+		selections[object.UserId] = inventory.selection()
+	}
+	return selections, nil
+}
+
+func newCosmeticInventory() *cosmeticInventory {
+	return &cosmeticInventory{
+		Owned:      make(map[string]bool),
+		MarkSkin:   cosmeticDefaultMarkSkin,
+		BoardTheme: cosmeticDefaultBoardTheme,
+	}
+}
+
+func readCosmeticInventory(ctx context.Context, nk runtime.NakamaModule, userID string) (*cosmeticInventory, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: cosmeticCollection,
+			Key:        cosmeticKey,
+			UserID:     userID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	inventory := newCosmeticInventory()
+	if len(objects) == 0 {
+		return inventory, "*", nil
+	}
+	if err := json.Unmarshal([]byte(objects[0].Value), inventory); err != nil {
+		return nil, "", err
+	}
+	if inventory.Owned == nil {
+		inventory.Owned = make(map[string]bool)
+	}
+	return inventory, objects[0].Version, nil
+}
+
+func writeCosmeticInventory(ctx context.Context, nk runtime.NakamaModule, userID string, inventory *cosmeticInventory, version string) error {
+	write, err := cosmeticInventoryWrite(userID, inventory, version)
+	if err != nil {
+		return err
+	}
+	_, err = nk.StorageWrite(ctx, []*runtime.StorageWrite{write})
+	return err
+}
+
+func cosmeticInventoryWrite(userID string, inventory *cosmeticInventory, version string) (*runtime.StorageWrite, error) {
+	value, err := json.Marshal(inventory)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.StorageWrite{
+		Collection:      cosmeticCollection,
+		Key:             cosmeticKey,
+		UserID:          userID,
+		Value:           string(value),
+		Version:         version,
+		PermissionRead:  2, // Public read, so opponents can see what is equipped
+		PermissionWrite: 0, // Only server can write
+	}, nil
+}
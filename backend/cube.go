@@ -0,0 +1,83 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// 3D tic-tac-toe on a cube with the given number of cells along each edge, won by a line running the full width of the
+// cube in any direction, including the diagonals across layers and through the centre.
+type cubeRules struct {
+	size int32
+}
+
+// Winning lines for each supported cube size: 49 for 3x3x3 and 76 for 4x4x4.
+var cubeLines = map[int32][][]int32{
+	3: generateCubeLines(3),
+	4: generateCubeLines(4),
+}
+
+func (r cubeRules) shape() *api.BoardShape {
+	return &api.BoardShape{Layers: r.size, Rows: r.size, Columns: r.size}
+}
+
+func (cubeRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != move.Mark {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	return checkPosition(board, move.Position)
+}
+
+func (r cubeRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	if line := completedLine(board, move.Mark, cubeLines[r.size]); line != nil {
+		return true, move.Mark, line
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// Every line of the given length through a cube of that size. Each line is generated once, by walking in one
+// direction from each cell where a full-length line in that direction fits.
+func generateCubeLines(size int32) [][]int32 {
+	var lines [][]int32
+	for dl := int32(-1); dl <= 1; dl++ {
+		for dr := int32(-1); dr <= 1; dr++ {
+			for dc := int32(-1); dc <= 1; dc++ {
+				// Skip the zero direction, and every direction whose reverse is also walked.
+				if dl < 0 || (dl == 0 && dr < 0) || (dl == 0 && dr == 0 && dc <= 0) {
+					continue
+				}
+				for l := int32(0); l < size; l++ {
+					for r := int32(0); r < size; r++ {
+						for c := int32(0); c < size; c++ {
+							line := make([]int32, 0, size)
+							for i := int32(0); i < size; i++ {
+								cl, cr, cc := l+i*dl, r+i*dr, c+i*dc
+								if cl < 0 || cl >= size || cr < 0 || cr >= size || cc < 0 || cc >= size {
+									break
+								}
+								line = append(line, (cl*size+cr)*size+cc)
+							}
+							if int32(len(line)) == size {
+								lines = append(lines, line)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+func TestGenerateCubeLines(t *testing.T) {
+	tests := []struct {
+		size int32
+		want int
+	}{
+		{3, 49},
+		{4, 76},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%dx%[1]dx%[1]d", tt.size), func(t *testing.T) {
+			lines := generateCubeLines(tt.size)
+			if len(lines) != tt.want {
+				t.Fatalf("generateCubeLines() gave %v lines, want %v", len(lines), tt.want)
+			}
+			seen := make(map[string]bool, len(lines))
+			for _, line := range lines {
+				if int32(len(line)) != tt.size {
+					t.Errorf("line %v has %v cells, want %v", line, len(line), tt.size)
+				}
+				for _, position := range line {
+					if position < 0 || position >= tt.size*tt.size*tt.size {
+						t.Errorf("line %v leaves the cube", line)
+					}
+				}
+				sorted := slices.Sorted(slices.Values(line))
+				if key := fmt.Sprint(sorted); seen[key] {
+					t.Errorf("line %v generated twice", line)
+				} else {
+					seen[key] = true
+				}
+			}
+		})
+	}
+}
+
+func TestCubeResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int32
+		positions  []int32
+		over       bool
+		winnerLine []int32
+	}{
+		{"row in a layer", 3, []int32{9, 10, 11}, true, []int32{9, 10, 11}},
+		{"column through the layers", 3, []int32{4, 13, 22}, true, []int32{4, 13, 22}},
+		{"diagonal across layers", 3, []int32{0, 12, 24}, true, []int32{0, 12, 24}},
+		{"through the centre", 3, []int32{2, 13, 24}, true, []int32{2, 13, 24}},
+		{"corner to corner on the big cube", 4, []int32{0, 21, 42, 63}, true, []int32{0, 21, 42, 63}},
+		{"three of four on the big cube", 4, []int32{0, 21, 42}, false, nil},
+		{"bent line", 3, []int32{0, 1, 5}, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := make([]api.Mark, tt.size*tt.size*tt.size)
+			for _, position := range tt.positions {
+				board[position] = api.Mark_MARK_X
+			}
+			move := &moveRecord{Mark: api.Mark_MARK_X, Piece: api.Mark_MARK_X}
+			over, winner, line := (cubeRules{size: tt.size}).result(board, nil, move)
+			wantWinner := api.Mark_MARK_UNSPECIFIED
+			if tt.over {
+				wantWinner = api.Mark_MARK_X
+			}
+			if over != tt.over || winner != wantWinner || !slices.Equal(line, tt.winnerLine) {
+				t.Errorf("result() = %v, %v, %v, want %v, %v, %v", over, winner, line, tt.over, wantWinner, tt.winnerLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	nkapi "github.com/heroiclabs/nakama-common/api"
+)
+
+const (
+	dailyRewardCollection = "daily_rewards"
+	dailyRewardKey        = "streak"
+
+	walletReasonDailyReward = "daily_reward"
+)
+
+// Rewards for each consecutive day claimed. Streaks longer than this keep receiving the final amount.
+var dailyRewardAmounts = []int64{100, 150, 200, 250, 300, 400, 500}
+
+type dailyRewardStreak struct {
+	// Unix time of the most recent claim.
+	LastClaimUnix int64 `json:"last_claim_unix"`
+	// Number of consecutive UTC days claimed, ending with the most recent claim.
+	Streak int32 `json:"streak"`
+}
+
+// Grant the caller today's reward, extending their streak if they also claimed yesterday.
+func rpcClaimDailyReward(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		streak, version, err := readDailyRewardStreak(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading daily reward streak: %v", err)
+			return "", errInternalError
+		}
+
+		today := utcDay(time.Now())
+		lastClaimDay := utcDay(time.Unix(streak.LastClaimUnix, 0))
+		switch {
+		case streak.LastClaimUnix != 0 && lastClaimDay.Equal(today):
+			return "", errAlreadyClaimed
+		case streak.LastClaimUnix != 0 && lastClaimDay.Equal(today.AddDate(0, 0, -1)):
+			streak.Streak++
+		default:
+			streak.Streak = 1
+		}
+		streak.LastClaimUnix = time.Now().Unix()
+
+		value, err := json.Marshal(streak)
+		if err != nil {
+			logger.Error("error encoding daily reward streak: %v", err)
+			return "", errMarshal
+		}
+
+		// Write the streak with the version we read so concurrent claims cannot both succeed, and grant the reward in
+		// the same update so a claim never counts without the coins.
+		reward := dailyRewardAmount(streak.Streak)
+		if _, _, err := nk.MultiUpdate(ctx, nil, []*runtime.StorageWrite{
+			{
+				Collection:      dailyRewardCollection,
+				Key:             dailyRewardKey,
+				UserID:          userID,
+				Value:           string(value),
+				Version:         version,
+				PermissionRead:  1, // Owner read
+				PermissionWrite: 0, // Only server can write
+			},
+		}, nil, []*runtime.WalletUpdate{
+			{
+				UserID:    userID,
+				Changeset: map[string]int64{walletCurrency: reward},
+				Metadata:  map[string]interface{}{"reason": walletReasonDailyReward, "streak": streak.Streak},
+			},
+		}, true); err != nil {
+			// Tell a claim that lost the race to a concurrent one apart from a failed update.
+			if current, _, readErr := readDailyRewardStreak(ctx, nk, userID); readErr == nil && current.LastClaimUnix != 0 && utcDay(time.Unix(current.LastClaimUnix, 0)).Equal(today) {
+				logger.Info("daily reward already claimed concurrently: %v", err)
+				return "", errAlreadyClaimed
+			}
+			logger.Error("error granting daily reward: %v", err)
+			return "", errInternalError
+		}
+
+		response, err := marshaler.Marshal(&api.RpcClaimDailyRewardResponse{
+			Reward:        reward,
+			Streak:        streak.Streak,
+			NextClaimTime: today.AddDate(0, 0, 1).Unix(),
+		})
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(response), nil
+	}
+}
+
+// Let the user know a daily reward is waiting the first time they come online on a new UTC day.
+func eventSessionStartDailyRewardFunc(nk runtime.NakamaModule) func(context.Context, runtime.Logger, *nkapi.Event) {
+	return func(ctx context.Context, logger runtime.Logger, evt *nkapi.Event) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			logger.Error("context did not contain user ID.")
+			return
+		}
+
+		ctx2, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		account, err := nk.AccountGetId(ctx2, userID)
+		if err != nil {
+			logger.WithField("err", err).Error("nk.AccountGetId error.")
+			return
+		}
+
+		// The last online time is stamped when a session ends, so a value from today means the user was already
+		// online and has had the chance to see the notification.
+		var metadata struct {
+			LastOnlineTimeUnix int64 `json:"last_online_time_unix"`
+		}
+		if account.GetUser().GetMetadata() != "" {
+			if err := json.Unmarshal([]byte(account.GetUser().GetMetadata()), &metadata); err != nil {
+				logger.WithField("err", err).Error("account metadata decode error.")
+				return
+			}
+		}
+		today := utcDay(time.Now())
+		if !utcDay(time.Unix(metadata.LastOnlineTimeUnix, 0)).Before(today) {
+			return
+		}
+
+		streak, _, err := readDailyRewardStreak(ctx2, nk, userID)
+		if err != nil {
+			logger.WithField("err", err).Error("daily reward streak read error.")
+			return
+		}
+		if streak.LastClaimUnix != 0 && !utcDay(time.Unix(streak.LastClaimUnix, 0)).Before(today) {
+			return
+		}
+
+		nextStreak := int32(1)
+		if streak.LastClaimUnix != 0 && utcDay(time.Unix(streak.LastClaimUnix, 0)).Equal(today.AddDate(0, 0, -1)) {
+			nextStreak = streak.Streak + 1
+		}
+
+		content := map[string]interface{}{
+			"reward": dailyRewardAmount(nextStreak),
+			"streak": nextStreak,
+		}
+		if err := nk.NotificationSend(ctx2, userID, "Your daily reward is ready!", content, notificationCodeDailyReward, "", true); err != nil {
+			logger.WithField("err", err).Error("nk.NotificationSend error.")
+		}
+	}
+}
+
+func readDailyRewardStreak(ctx context.Context, nk runtime.NakamaModule, userID string) (*dailyRewardStreak, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: dailyRewardCollection,
+			Key:        dailyRewardKey,
+			UserID:     userID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	streak := &dailyRewardStreak{}
+	if len(objects) == 0 {
+		// Only create the object if no other claim has done so in the meantime.
+		return streak, "*", nil
+	}
+	if err := json.Unmarshal([]byte(objects[0].Value), streak); err != nil {
+		return nil, "", err
+	}
+	return streak, objects[0].Version, nil
+}
+
+func dailyRewardAmount(streak int32) int64 {
+	if streak < 1 {
+		streak = 1
+	}
+	if int(streak) > len(dailyRewardAmounts) {
+		return dailyRewardAmounts[len(dailyRewardAmounts)-1]
+	}
+	return dailyRewardAmounts[streak-1]
+}
+
+// Truncate a time to the start of its UTC day.
+func utcDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,364 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	nkapi "github.com/heroiclabs/nakama-common/api"
+)
+
+const (
+	leaderboardDefaultLimit = 10
+	leaderboardMaxLimit     = 100
+
+	// Friend state for mutual friends, and the highest group state that still counts as a member.
+	friendStateMutual = 0
+	groupStateMember  = 2
+
+	// Page size for listing friends and group members.
+	leaderboardOwnerPageSize = 100
+)
+
+// ISO 3166-1 alpha-2 codes, each with its own country leaderboard.
+var countryCodes = strings.Fields(`
+AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ
+BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR
+CU CV CW CX CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR
+GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU
+ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ
+LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ
+MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF
+PG PH PK PL PM PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI
+SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR
+TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW
+`)
+
+// Create the leaderboard for every known country. Only these are ever written to or listed.
+func createCountryLeaderboards(ctx context.Context, nk runtime.NakamaModule) error {
+	for _, country := range countryCodes {
+		if err := nk.LeaderboardCreate(ctx, countryLeaderboardId(country), true, "descending", "best", "", nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Return the caller's rank on the global leaderboard along with the records around it.
+func rpcLeaderboardAroundMe(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request, err := unmarshalLeaderboardRequest(unmarshaler, payload)
+		if err != nil {
+			return "", err
+		}
+
+		response, err := leaderboardHaystack(ctx, nk, leaderboardId, userID, leaderboardLimit(request))
+		if err != nil {
+			logger.Error("error listing leaderboard around user: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalLeaderboardResponse(logger, marshaler, response)
+	}
+}
+
+// Return the caller's rank among their mutual friends.
+func rpcLeaderboardFriends(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request, err := unmarshalLeaderboardRequest(unmarshaler, payload)
+		if err != nil {
+			return "", err
+		}
+
+		state := friendStateMutual
+		ownerIDs := []string{userID}
+		cursor := ""
+		for {
+			friends, next, err := nk.FriendsList(ctx, userID, leaderboardOwnerPageSize, &state, cursor)
+			if err != nil {
+				logger.Error("error listing friends: %v", err)
+				return "", errInternalError
+			}
+			for _, friend := range friends {
+				ownerIDs = append(ownerIDs, friend.GetUser().GetId())
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		response, err := leaderboardForOwners(ctx, nk, ownerIDs, userID, leaderboardLimit(request))
+		if err != nil {
+			logger.Error("error listing friends leaderboard: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalLeaderboardResponse(logger, marshaler, response)
+	}
+}
+
+// Return the caller's rank among the members of a group they belong to.
+func rpcLeaderboardGroup(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request, err := unmarshalLeaderboardRequest(unmarshaler, payload)
+		if err != nil {
+			return "", err
+		}
+		if request.GroupId == "" {
+			return "", errInvalidInput
+		}
+
+		var isMember bool
+		var ownerIDs []string
+		cursor := ""
+		for {
+			members, next, err := nk.GroupUsersList(ctx, request.GroupId, leaderboardOwnerPageSize, nil, cursor)
+			if err != nil {
+				logger.Error("error listing group members: %v", err)
+				return "", errInternalError
+			}
+			for _, member := range members {
+				// Skip users who have only requested to join.
+				if member.GetState().GetValue() > groupStateMember {
+					continue
+				}
+				if member.GetUser().GetId() == userID {
+					isMember = true
+				}
+				ownerIDs = append(ownerIDs, member.GetUser().GetId())
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		if !isMember {
+			return "", errNotGroupMember
+		}
+
+		response, err := leaderboardForOwners(ctx, nk, ownerIDs, userID, leaderboardLimit(request))
+		if err != nil {
+			logger.Error("error listing group leaderboard: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalLeaderboardResponse(logger, marshaler, response)
+	}
+}
+
+// Return the caller's rank on a country leaderboard, by default the one matching their account location.
+func rpcLeaderboardCountry(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request, err := unmarshalLeaderboardRequest(unmarshaler, payload)
+		if err != nil {
+			return "", err
+		}
+
+		country := normalizeCountry(request.Country)
+		if request.Country == "" {
+			account, err := nk.AccountGetId(ctx, userID)
+			if err != nil {
+				logger.Error("error reading account: %v", err)
+				return "", errInternalError
+			}
+			country = normalizeCountry(account.GetUser().GetLocation())
+		}
+		if country == "" {
+			return "", errInvalidInput
+		}
+
+		response, err := leaderboardHaystack(ctx, nk, countryLeaderboardId(country), userID, leaderboardLimit(request))
+		if err != nil {
+			logger.Error("error listing country leaderboard: %v", err)
+			return "", errInternalError
+		}
+
+		return marshalLeaderboardResponse(logger, marshaler, response)
+	}
+}
+
+func unmarshalLeaderboardRequest(unmarshaler *protojson.UnmarshalOptions, payload string) (*api.RpcLeaderboardRequest, error) {
+	request := &api.RpcLeaderboardRequest{}
+	if payload == "" {
+		return request, nil
+	}
+	if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+		return nil, errUnmarshal
+	}
+	return request, nil
+}
+
+func marshalLeaderboardResponse(logger runtime.Logger, marshaler *protojson.MarshalOptions, response *api.RpcLeaderboardResponse) (string, error) {
+	buf, err := marshaler.Marshal(response)
+	if err != nil {
+		logger.Error("error marshaling response payload: %v", err.Error())
+		return "", errMarshal
+	}
+	return string(buf), nil
+}
+
+func leaderboardLimit(request *api.RpcLeaderboardRequest) int {
+	limit := int(request.Limit)
+	if limit <= 0 {
+		return leaderboardDefaultLimit
+	}
+	if limit > leaderboardMaxLimit {
+		return leaderboardMaxLimit
+	}
+	return limit
+}
+
+// List the records surrounding the given user, as ranked by the leaderboard itself.
+func leaderboardHaystack(ctx context.Context, nk runtime.NakamaModule, id, userID string, limit int) (*api.RpcLeaderboardResponse, error) {
+	list, err := nk.LeaderboardRecordsHaystack(ctx, id, userID, limit, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.RpcLeaderboardResponse{}
+	for _, record := range list.GetRecords() {
+		entry := leaderboardEntry(record, record.Rank)
+		if record.OwnerId == userID {
+			response.Self = entry
+		}
+		response.Records = append(response.Records, entry)
+	}
+	return response, nil
+}
+
+// Rank the records owned by a specific set of users against each other.
+func leaderboardForOwners(ctx context.Context, nk runtime.NakamaModule, ownerIDs []string, userID string, limit int) (*api.RpcLeaderboardResponse, error) {
+	_, records, _, _, err := nk.LeaderboardRecordsList(ctx, leaderboardId, ownerIDs, 0, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Score != records[j].Score {
+			return records[i].Score > records[j].Score
+		}
+		return records[i].Subscore > records[j].Subscore
+	})
+
+	response := &api.RpcLeaderboardResponse{}
+	for i, record := range records {
+		entry := leaderboardEntry(record, int64(i+1))
+		if record.OwnerId == userID {
+			response.Self = entry
+		}
+		if i < limit {
+			response.Records = append(response.Records, entry)
+		}
+	}
+	return response, nil
+}
+
+func leaderboardEntry(record *nkapi.LeaderboardRecord, rank int64) *api.LeaderboardEntry {
+	return &api.LeaderboardEntry{
+		UserId:   record.OwnerId,
+		Username: record.GetUsername().GetValue(),
+		Score:    record.Score,
+		Rank:     rank,
+		Stats:    playerStatsFromMetadata(record.Metadata),
+	}
+}
+
+// Decode the win, loss and draw totals stored in a leaderboard record's metadata.
+func playerStatsFromMetadata(metadata string) *api.PlayerStats {
+	stats := &api.PlayerStats{}
+	if metadata == "" {
+		return stats
+	}
+
+	var raw struct {
+		Wins   float64 `json:"wins"`
+		Losses float64 `json:"losses"`
+		Draws  float64 `json:"draws"`
+	}
+	if err := json.Unmarshal([]byte(metadata), &raw); err != nil {
+		return stats
+	}
+	stats.Wins = int64(raw.Wins)
+	stats.Losses = int64(raw.Losses)
+	stats.Draws = int64(raw.Draws)
+	return stats
+}
+
+// Country codes are expected as ISO 3166-1 alpha-2, anything else is treated as unknown.
+func normalizeCountry(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !slices.Contains(countryCodes, country) {
+		return ""
+	}
+	return country
+}
+
+func countryLeaderboardId(country string) string {
+	return leaderboardId + "_" + strings.ToLower(country)
+}
+
+// Mirror a user's global leaderboard record onto the leaderboard for their account location, if known.
+func writeCountryLeaderboard(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger, userId string,
+	userName string, score int64, metadata map[string]interface{}) {
+	account, err := nk.AccountGetId(ctx, userId)
+	if err != nil {
+		logger.Error("error reading account for country leaderboard: %v", err)
+		return
+	}
+
+	country := normalizeCountry(account.GetUser().GetLocation())
+	if country == "" {
+		return
+	}
+
+	id := countryLeaderboardId(country)
+	if err := nk.LeaderboardRecordDelete(ctx, id, userId); err != nil {
+		logger.Warn("error deleting country leaderboard entry: %v", err)
+	}
+
+	if _, err := nk.LeaderboardRecordWrite(ctx, id, userId, userName, score, 0, metadata, nil); err != nil {
+		logger.Error("error writing country leaderboard entry: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+// Match lifecycle events. Each is logged at info level with the event name as both the message and the "event" field,
+// alongside the match fields added by matchLogger, so log-based alerting can filter on them.
+const (
+	logEventMatchCreated       = "match_created"
+	logEventMatchClosed        = "match_closed"
+	logEventJoinRejected       = "join_rejected"
+	logEventPlayerJoined       = "player_joined"
+	logEventPlayerRejoined     = "player_rejoined"
+	logEventPlayerLeft         = "player_left"
+	logEventGameStarted        = "game_started"
+	logEventGameResumed        = "game_resumed"
+	logEventGameEnded          = "game_ended"
+	logEventLeaderboardUpdated = "leaderboard_updated"
+)
+
+// Attach the fields identifying a match, the current tick and the game number to a logger.
+func matchLogger(ctx context.Context, logger runtime.Logger, s *MatchState, tick int64) runtime.Logger {
+	fields := map[string]interface{}{
+		"match_id": matchIdFromContext(ctx),
+		"tick":     tick,
+	}
+	if s != nil {
+		fields["game"] = s.gameNumber
+	}
+	return logger.WithFields(fields)
+}
+
+func logMatchEvent(logger runtime.Logger, event string, fields map[string]interface{}) {
+	if fields == nil {
+		fields = make(map[string]interface{}, 1)
+	}
+	fields["event"] = event
+	logger.WithFields(fields).Info(event)
+}
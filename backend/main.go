@@ -24,14 +24,61 @@ import (
 )
 
 var (
-	errInternalError = runtime.NewError("internal server error", 13) // INTERNAL
-	errMarshal       = runtime.NewError("cannot marshal type", 13)   // INTERNAL
-	errNoUserIdFound = runtime.NewError("no user ID in context", 3)  // INVALID_ARGUMENT
-	errUnmarshal     = runtime.NewError("cannot unmarshal type", 13) // INTERNAL
+	errAdminCommandRejected = runtime.NewError("match rejected the command", 9)   // FAILED_PRECONDITION
+	errAlreadyClaimed       = runtime.NewError("reward already claimed today", 6) // ALREADY_EXISTS
+	errAlreadyOwned         = runtime.NewError("item already owned", 6)           // ALREADY_EXISTS
+	errGameChanged          = runtime.NewError("game changed, try again", 10)     // ABORTED
+	errGameNotFound         = runtime.NewError("game not found", 5)               // NOT_FOUND
+	errGameNotStarted       = runtime.NewError("game not accepted yet", 9)        // FAILED_PRECONDITION
+	errInsufficientFunds    = runtime.NewError("insufficient funds", 9)           // FAILED_PRECONDITION
+	errInternalError        = runtime.NewError("internal server error", 13)       // INTERNAL
+	errInvalidInput         = runtime.NewError("invalid request input", 3)        // INVALID_ARGUMENT
+	errMarshal              = runtime.NewError("cannot marshal type", 13)         // INTERNAL
+	errMatchNotFound        = runtime.NewError("match not found", 5)              // NOT_FOUND
+	errMatchRunning         = runtime.NewError("match is still running", 9)       // FAILED_PRECONDITION
+	errNoUserIdFound        = runtime.NewError("no user ID in context", 3)        // INVALID_ARGUMENT
+	errNotGroupMember       = runtime.NewError("user is not a group member", 7)   // PERMISSION_DENIED
+	errNotOwned             = runtime.NewError("item not owned", 9)               // FAILED_PRECONDITION
+	errPermissionDenied     = runtime.NewError("permission denied", 7)            // PERMISSION_DENIED
+	errTooManyGames         = runtime.NewError("too many open games", 8)          // RESOURCE_EXHAUSTED
+	errUnmarshal            = runtime.NewError("cannot unmarshal type", 13)       // INTERNAL
 )
 
 const (
 	rpcIdFindMatch = "find_match"
+
+	rpcIdLeaderboardAroundMe = "leaderboard_around_me"
+	rpcIdLeaderboardFriends  = "leaderboard_friends"
+	rpcIdLeaderboardGroup    = "leaderboard_group"
+	rpcIdLeaderboardCountry  = "leaderboard_country"
+
+	rpcIdWalletLedger     = "wallet_ledger"
+	rpcIdClaimDailyReward = "claim_daily_reward"
+	rpcIdListAchievements = "list_achievements"
+	rpcIdListCosmetics    = "list_cosmetics"
+	rpcIdPurchaseCosmetic = "purchase_cosmetic"
+	rpcIdEquipCosmetic    = "equip_cosmetic"
+	rpcIdMatchChatHistory = "match_chat_history"
+	rpcIdResumeMatch      = "resume_match"
+	rpcIdListMatchHistory = "list_match_history"
+	rpcIdAnalyzeGame      = "analyze_game"
+	rpcIdCreateAsyncGame  = "create_async_game"
+	rpcIdAcceptAsyncGame  = "accept_async_game"
+	rpcIdDeclineAsyncGame = "decline_async_game"
+	rpcIdSubmitAsyncMove  = "submit_async_move"
+	rpcIdListAsyncGames   = "list_async_games"
+
+	rpcIdAdminListMatches      = "admin_list_matches"
+	rpcIdAdminInspectMatch     = "admin_inspect_match"
+	rpcIdAdminEndMatch         = "admin_end_match"
+	rpcIdAdminKickPlayer       = "admin_kick_player"
+	rpcIdAdminAnnounce         = "admin_announce"
+	rpcIdAdminListFlagged      = "admin_list_flagged"
+	rpcIdAdminExcludePlayer    = "admin_exclude_player"
+	rpcIdAdminExpireAsyncGames = "admin_expire_async_games"
+	rpcIdAdminExpireSnapshots  = "admin_expire_snapshots"
+
+	leaderboardId = "xoxo_leaderboard"
 )
 
 // noinspection GoUnusedExportedFunction
@@ -50,6 +97,43 @@ func InitModule(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runti
 		return err
 	}
 
+	rpcs := map[string]nakamaRpcFunc{
+		rpcIdLeaderboardAroundMe:   rpcLeaderboardAroundMe(marshaler, unmarshaler),
+		rpcIdLeaderboardFriends:    rpcLeaderboardFriends(marshaler, unmarshaler),
+		rpcIdLeaderboardGroup:      rpcLeaderboardGroup(marshaler, unmarshaler),
+		rpcIdLeaderboardCountry:    rpcLeaderboardCountry(marshaler, unmarshaler),
+		rpcIdWalletLedger:          rpcWalletLedger(marshaler, unmarshaler),
+		rpcIdClaimDailyReward:      rpcClaimDailyReward(marshaler, unmarshaler),
+		rpcIdListAchievements:      rpcListAchievements(marshaler, unmarshaler),
+		rpcIdListCosmetics:         rpcListCosmetics(marshaler, unmarshaler),
+		rpcIdPurchaseCosmetic:      rpcPurchaseCosmetic(marshaler, unmarshaler),
+		rpcIdEquipCosmetic:         rpcEquipCosmetic(marshaler, unmarshaler),
+		rpcIdMatchChatHistory:      rpcMatchChatHistory(marshaler, unmarshaler),
+		rpcIdResumeMatch:           rpcResumeMatch(marshaler, unmarshaler),
+		rpcIdListMatchHistory:      rpcListMatchHistory(marshaler, unmarshaler),
+		rpcIdAnalyzeGame:           rpcAnalyzeGame(marshaler, unmarshaler),
+		rpcIdCreateAsyncGame:       rpcCreateAsyncGame(marshaler, unmarshaler),
+		rpcIdAcceptAsyncGame:       rpcAcceptAsyncGame(marshaler, unmarshaler),
+		rpcIdDeclineAsyncGame:      rpcDeclineAsyncGame(marshaler, unmarshaler),
+		rpcIdSubmitAsyncMove:       rpcSubmitAsyncMove(marshaler, unmarshaler),
+		rpcIdListAsyncGames:        rpcListAsyncGames(marshaler, unmarshaler),
+		rpcIdAdminListMatches:      rpcAdminListMatches(marshaler, unmarshaler),
+		rpcIdAdminInspectMatch:     rpcAdminInspectMatch(marshaler, unmarshaler),
+		rpcIdAdminEndMatch:         rpcAdminEndMatch(marshaler, unmarshaler),
+		rpcIdAdminKickPlayer:       rpcAdminKickPlayer(marshaler, unmarshaler),
+		rpcIdAdminAnnounce:         rpcAdminAnnounce(marshaler, unmarshaler),
+		rpcIdAdminListFlagged:      rpcAdminListFlagged(marshaler, unmarshaler),
+		rpcIdAdminExcludePlayer:    rpcAdminExcludePlayer(marshaler, unmarshaler),
+		rpcIdAdminExpireAsyncGames: rpcAdminExpireAsyncGames(marshaler, unmarshaler),
+		rpcIdAdminExpireSnapshots:  rpcAdminExpireGameSnapshots(marshaler, unmarshaler),
+	}
+	for id, fn := range rpcs {
+		if err := initializer.RegisterRpc(id, fn); err != nil {
+			logger.Info("Unable to register rpc function %v: %v", id, err)
+			return err
+		}
+	}
+
 	logger.Info("rpc function registered successfully")
 
 	if err := initializer.RegisterMatch(moduleName, func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule) (runtime.Match, error) {
@@ -62,15 +146,29 @@ func InitModule(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runti
 		return err
 	}
 
-	if err := nk.LeaderboardCreate(ctx, "xoxo_leaderboard", true, "descending", "best", "", nil, false); err != nil {
+	if err := initializer.RegisterMatchmakerMatched(matchmakerMatched); err != nil {
+		logger.Error("Unable to register matchmaker matched hook: %v", err)
+		return err
+	}
+
+	if err := nk.LeaderboardCreate(ctx, leaderboardId, true, "descending", "best", "", nil, true); err != nil {
 		logger.Error("Error creating leaderboard: %v", err)
 		return err
 	}
 
+	if err := createCountryLeaderboards(ctx, nk); err != nil {
+		logger.Error("Error creating country leaderboards: %v", err)
+		return err
+	}
+
 	if err := registerSessionEvents(db, nk, initializer); err != nil {
 		return err
 	}
 
+	if err := registerWalletHooks(initializer); err != nil {
+		return err
+	}
+
 	logger.Info("Plugin loaded in '%d' msec.", time.Since(initStart).Milliseconds())
 	return nil
 }
@@ -0,0 +1,191 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	streamModeChannel            = 2
+	streamModeMatchAuthoritative = 4
+
+	matchChannelDefaultLimit = 50
+	matchChannelMaxLimit     = 100
+
+	// Events the server posts to a match's channel.
+	matchEventPlayerJoined      = "player_joined"
+	matchEventPlayerLeft        = "player_left"
+	matchEventPlayerReconnected = "player_reconnected"
+	matchEventGameStarted       = "game_started"
+	matchEventGameOver          = "game_over"
+	matchEventGameAbandoned     = "game_abandoned"
+	matchEventGameResumed       = "game_resumed"
+)
+
+// Room name of the chat channel tied to a match. Match IDs carry a node name suffix which is left out to keep the
+// name within the room name length limit.
+func matchChannelRoom(matchID string) string {
+	return "match_" + strings.SplitN(matchID, ".", 2)[0]
+}
+
+func matchChannelId(ctx context.Context, nk runtime.NakamaModule, matchID string) (string, error) {
+	return nk.ChannelIdBuild(ctx, "", matchChannelRoom(matchID), runtime.Room)
+}
+
+// Whether a user may read a match's chat: they played in the finished match, are in the match now, or are
+// currently in its channel.
+func matchChatReader(ctx context.Context, nk runtime.NakamaModule, matchID, userID string) (bool, error) {
+	replay, err := readMatchReplay(ctx, nk, matchID)
+	if err != nil {
+		return false, err
+	}
+	if replay != nil {
+		if _, ok := replay.Marks[userID]; ok {
+			return true, nil
+		}
+	}
+
+	if matchUUID, node, ok := strings.Cut(matchID, "."); ok {
+		if present, err := streamHasUser(nk, streamModeMatchAuthoritative, matchUUID, node, userID); err != nil || present {
+			return present, err
+		}
+	}
+	return streamHasUser(nk, streamModeChannel, "", matchChannelRoom(matchID), userID)
+}
+
+func streamHasUser(nk runtime.NakamaModule, mode uint8, subject, label, userID string) (bool, error) {
+	presences, err := nk.StreamUserList(mode, subject, "", label, true, true)
+	if err != nil {
+		return false, err
+	}
+	for _, presence := range presences {
+		if presence.GetUserId() == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Post a server message describing a match event to the match's channel, so it remains readable after the match.
+func postMatchEvent(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState, event, text string) {
+	if s.channelID == "" {
+		return
+	}
+
+	content := map[string]interface{}{
+		"event":    event,
+		"text":     text,
+		"match_id": matchIdFromContext(ctx),
+	}
+	if _, err := nk.ChannelMessageSend(ctx, s.channelID, content, "", "", true); err != nil {
+		logger.Error("error posting match event %v: %v", event, err)
+	}
+}
+
+// Describe the result of the game that just finished, naming the winner if there is one.
+func gameOverText(s *MatchState) string {
+	if s.winner == api.Mark_MARK_UNSPECIFIED {
+		return "Draw"
+	}
+
+	var names []string
+	for _, userID := range s.winners() {
+		if presence := s.presences[userID]; presence != nil {
+			names = append(names, presence.GetUsername())
+		}
+	}
+	if len(names) == 0 {
+		return markName(s.winner) + " wins"
+	}
+	if len(names) > 1 {
+		return strings.Join(names, " & ") + " (" + markName(s.winner) + ") win"
+	}
+	return names[0] + " (" + markName(s.winner) + ") wins"
+}
+
+// Read a page of the chat history for a match, which may have already ended.
+func rpcMatchChatHistory(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcMatchChatHistoryRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.MatchId == "" {
+			return "", errInvalidInput
+		}
+
+		limit := int(request.Limit)
+		if limit <= 0 {
+			limit = matchChannelDefaultLimit
+		} else if limit > matchChannelMaxLimit {
+			limit = matchChannelMaxLimit
+		}
+
+		allowed, err := matchChatReader(ctx, nk, request.MatchId, userID)
+		if err != nil {
+			logger.Error("error checking match chat access: %v", err)
+			return "", errInternalError
+		}
+		if !allowed {
+			return "", errPermissionDenied
+		}
+
+		channelID, err := matchChannelId(ctx, nk, request.MatchId)
+		if err != nil {
+			logger.Error("error building match channel ID: %v", err)
+			return "", errInvalidInput
+		}
+
+		messages, nextCursor, prevCursor, err := nk.ChannelMessagesList(ctx, channelID, limit, request.Forward, request.Cursor)
+		if err != nil {
+			logger.Error("error listing match channel messages: %v", err)
+			return "", errInternalError
+		}
+
+		response := &api.RpcMatchChatHistoryResponse{
+			Messages:   make([]*api.MatchChatMessage, 0, len(messages)),
+			NextCursor: nextCursor,
+			PrevCursor: prevCursor,
+		}
+		for _, message := range messages {
+			response.Messages = append(response.Messages, &api.MatchChatMessage{
+				MessageId:  message.MessageId,
+				SenderId:   message.SenderId,
+				Username:   message.Username,
+				Content:    message.Content,
+				CreateTime: message.GetCreateTime().GetSeconds(),
+			})
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
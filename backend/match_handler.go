@@ -39,6 +39,14 @@ const (
 	delayBetweenGamesSec = 5
 	turnTimeFastSec      = 5
 	turnTimeNormalSec    = 10
+
+	matchSpeedNormal = "normal"
+	matchSpeedFast   = "fast"
+
+	// Leaderboard score awarded for each game.
+	scoreWin  = 100
+	scoreLoss = -100
+	scoreDraw = 10
 )
 
 var winningPositions = [][]int32{
@@ -56,8 +64,36 @@ var winningPositions = [][]int32{
 var _ runtime.Match = &MatchHandler{}
 
 type MatchLabel struct {
-	Open int `json:"open"`
-	Fast int `json:"fast"`
+	Open  int   `json:"open"`
+	Fast  int   `json:"fast"`
+	Stake int64 `json:"stake"`
+	// The api.GameMode the match plays.
+	Mode int `json:"mode"`
+	// The api.Variant of the rules the match plays.
+	Variant int `json:"variant"`
+	// Number of players the match is for.
+	Players int `json:"players"`
+	// The api.WinRule deciding games with more than two sides.
+	WinRule int `json:"win_rule"`
+	// Number of players sharing each mark, 2 for team games.
+	TeamSize int `json:"team_size"`
+	// The api.TimeControl setting each side's clock.
+	TimeControl int `json:"time_control"`
+}
+
+// A single move played during a game.
+type moveRecord struct {
+	UserID   string   `json:"user_id"`
+	Mark     api.Mark `json:"mark"`
+	Position int32    `json:"position"`
+	// The mark placed, which only differs from the player's own mark in wild games.
+	Piece api.Mark `json:"piece,omitempty"`
+	// The digit placed in numerical games.
+	Value int32 `json:"value,omitempty"`
+	// Time the player took to make the move, in milliseconds.
+	ElapsedMs int64 `json:"elapsed_ms"`
+	// The client's sequence number for the move, zero if it did not send one.
+	Sequence int64 `json:"sequence,omitempty"`
 }
 
 type MatchHandler struct {
@@ -76,21 +112,63 @@ type MatchState struct {
 	presences map[string]runtime.Presence
 	// Number of users currently in the process of connecting to the match.
 	joinsInProgress int
+	// Ticks at which each user recently sent a chat message, for rate limiting.
+	chatTicks map[string][]int64
+	// For each user, the set of users whose chat they have muted.
+	mutes map[string]map[string]bool
 
 	// True if there's a game currently in progress.
 	playing bool
 	// Current state of the board.
 	board []api.Mark
-	// Mark assignments to player user IDs.
+	// Moves played so far in the current game, in order.
+	moves []*moveRecord
+	// Mark assignments to player user IDs. Teammates share a mark.
 	marks map[string]api.Mark
+	// The players sharing each mark, in the order they take their turns.
+	rotation map[api.Mark][]string
+	// Teams chosen for players by the matchmaker or their party, keyed by user ID.
+	teams map[string]int
+	// Seats held for players placed in the match who have not joined yet, keyed by user ID.
+	reserved map[string]bool
+	// Tick at which seats still held are given up.
+	reservedUntil int64
+	// Tick at which a match resuming a saved game gives up waiting for its players.
+	resumeUntil int64
+	// The party each player came with, keyed by user ID, so it can be told if they drop out before the first game.
+	parties map[string]string
 	// Whose turn it currently is.
 	mark api.Mark
-	// Ticks until they must submit their move.
+	// Ticks until they must submit their move, when there is no time control.
 	deadlineRemainingTicks int64
+	// Milliseconds left on each side's clock as of the start of the current turn, nil when there is no time control.
+	clocks map[api.Mark]int64
+	// When the current turn began.
+	turnStartedAt time.Time
+	// When the current game began.
+	gameStartedAt time.Time
 	// The winner of the current game.
 	winner api.Mark
 	// The winner positions.
 	winnerPositions []int32
+	// Places decided in the current game, keyed by mark. Filled in for every side when the game ends.
+	placements map[api.Mark]int32
+	// True if the game ended because a player ran out of time.
+	forfeit bool
+	// Stakes currently held on behalf of each player for the game in progress.
+	escrow map[string]int64
+	// Chat channel where match events are logged.
+	channelID string
+	// Number of games started in this match, including the current one.
+	gameNumber int
+	// Set when the match should close on its next tick.
+	closeReason string
+	// True once the server has started shutting down, while the match waits out the grace period.
+	terminating bool
+	// A game from a lost match, to carry on with once its players have all joined.
+	resume *gameSnapshot
+	// Message accounting for each player, keyed by user ID.
+	budgets map[string]*messageBudget
 }
 
 func (ms *MatchState) ConnectedCount() int {
@@ -103,9 +181,13 @@ func (ms *MatchState) ConnectedCount() int {
 	return count
 }
 
+// Number of seats not taken by a player or held for one.
+func (ms *MatchState) openSeats() int {
+	return ms.label.playerCount() - len(ms.presences) - len(ms.reserved)
+}
+
 func (m *MatchHandler) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
-	logger.Info("MatchInit called.")
-	logger.Info("MatchInit fast: %v", params["fast"])
+	logger = matchLogger(ctx, logger, nil, 0)
 
 	fast, ok := params["fast"].(int)
 	if !ok {
@@ -113,13 +195,82 @@ func (m *MatchHandler) MatchInit(ctx context.Context, logger runtime.Logger, db
 		return nil, 0, ""
 	}
 
+	// Matches created without a stake are free to play.
+	stake, _ := params["stake"].(int64)
+	// Matches created without a mode play classic games.
+	mode, _ := params["mode"].(int)
+	if _, ok := api.GameMode_name[int32(mode)]; !ok {
+		logger.Error("invalid match init parameter \"mode\"")
+		return nil, 0, ""
+	}
+	variant, _ := params["variant"].(int)
+	if _, ok := api.Variant_name[int32(variant)]; !ok || !variantAllowed(api.GameMode(mode), api.Variant(variant)) {
+		logger.Error("invalid match init parameter \"variant\"")
+		return nil, 0, ""
+	}
+	// Matches created without a player count are for two.
+	players, _ := params["players"].(int)
+	if players == 0 {
+		players = minPlayers
+	}
+	winRule, _ := params["win_rule"].(int)
+	// Team games are for two teams, and may come with the players already split into them.
+	teamSize, _ := params["team_size"].(int)
+	if teamSize == 0 {
+		teamSize = 1
+	}
+	if teamSize != 1 && (teamSize != playersPerTeam || players != 2*playersPerTeam) {
+		logger.Error("invalid match init parameter \"team_size\"")
+		return nil, 0, ""
+	}
+	teams, _ := params["teams"].(map[string]int)
+	// Matches created without a time control give each turn a fixed time.
+	timeControl, _ := params["time_control"].(int)
+	if _, ok := api.TimeControl_name[int32(timeControl)]; !ok {
+		logger.Error("invalid match init parameter \"time_control\"")
+		return nil, 0, ""
+	}
+	// Players placed in the match by their party or the matchmaker have seats held for them, keyed by user ID with the
+	// party they came with, if any.
+	reservedParties, _ := params["reserved"].(map[string]string)
+	if len(reservedParties) > players {
+		logger.Error("invalid match init parameter \"reserved\"")
+		return nil, 0, ""
+	}
+	if _, ok := api.WinRule_name[int32(winRule)]; !ok || !playersAllowed(api.GameMode(mode), api.Variant(variant), players/teamSize) {
+		logger.Error("invalid match init parameter \"players\"")
+		return nil, 0, ""
+	}
+
 	label := &MatchLabel{
-		Open: 1,
+		Open:     1,
+		Stake:    stake,
+		Mode:     mode,
+		Variant:  variant,
+		Players:  players,
+		WinRule:  winRule,
+		TeamSize: teamSize,
+
+		TimeControl: timeControl,
 	}
 	if fast == 1 {
 		label.Fast = 1
 	}
-	logger.Info("MatchInit Fast: %v", label.Fast)
+
+	// A match resuming a saved game is reserved for that game's players.
+	resume, _ := params["resume"].(*gameSnapshot)
+	if resume != nil || len(reservedParties) == players {
+		label.Open = 0
+	}
+
+	reserved := make(map[string]bool, len(reservedParties))
+	parties := make(map[string]string, len(reservedParties))
+	for userID, partyID := range reservedParties {
+		reserved[userID] = true
+		if partyID != "" {
+			parties[userID] = partyID
+		}
+	}
 
 	labelJSON, err := json.Marshal(label)
 	if err != nil {
@@ -127,80 +278,127 @@ func (m *MatchHandler) MatchInit(ctx context.Context, logger runtime.Logger, db
 		labelJSON = []byte("{}")
 	}
 
+	nk.MetricsCounterAdd(metricMatchesCreated, labelTags(label), 1)
+	if label.Open == 1 {
+		addOpenMatches(nk, label, 1)
+	}
+
+	channelID, err := matchChannelId(ctx, nk, matchIdFromContext(ctx))
+	if err != nil {
+		logger.Error("error building match channel ID: %v", err)
+	}
+
 	state := &MatchState{
+		channelID: channelID,
 		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
 		label:     label,
 		presences: make(map[string]runtime.Presence, 2),
 		messages:  make(chan runtime.MatchData, 1),
+		chatTicks: make(map[string][]int64, 2),
+		mutes:     make(map[string]map[string]bool, 2),
+		resume:    resume,
+		teams:     teams,
+		reserved:  reserved,
+		parties:   parties,
+		budgets:   make(map[string]*messageBudget, 2),
+
+		reservedUntil: partyReserveSec * tickRate,
+		resumeUntil:   resumeJoinSec * tickRate,
 	}
 
+	logMatchEvent(logger, logEventMatchCreated, map[string]interface{}{"fast": label.Fast, "stake": label.Stake, "mode": gameModeName(api.GameMode(label.Mode)), "variant": variantName(api.Variant(label.Variant)), "players": label.Players, "team_size": label.TeamSize, "time_control": timeControlName(api.TimeControl(label.TimeControl))})
 	return state, tickRate, string(labelJSON)
 }
 
 func (m *MatchHandler) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
-	logger.Info("MatchJoinAttempt called.")
-
 	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick).WithField("user_id", presence.GetUserId())
+
+	if s.terminating {
+		logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "server shutting down"})
+		return s, false, "server shutting down"
+	}
+
+	if budget, ok := s.budgets[presence.GetUserId()]; ok && budget.kicked {
+		logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "kicked"})
+		return s, false, "kicked"
+	}
 
 	// Check if it's a user attempting to rejoin after a disconnect.
 	if presence, ok := s.presences[presence.GetUserId()]; ok {
-		logger.Info("presence: %v", presence)
 		if presence == nil {
 			// User rejoining after a disconnect.
 			s.joinsInProgress++
 			return s, true, ""
 		} else {
 			// User attempting to join from 2 different devices at the same time.
+			logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "already joined"})
 			return s, false, "already joined"
 		}
 	}
 
-	// Check if match is full.
-	if len(s.presences)+s.joinsInProgress >= 2 {
-		logger.Info("Match is full.")
+	if s.resume != nil {
+		if _, ok := s.resume.Marks[presence.GetUserId()]; !ok {
+			logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "match reserved"})
+			return s, false, "match reserved"
+		}
+	}
+
+	// Check if match is full. Players with a seat held for them always fit.
+	if !s.reserved[presence.GetUserId()] && s.openSeats()-s.joinsInProgress <= 0 {
+		logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "match full"})
 		return s, false, "match full"
 	}
 
+	// Check if the player can cover the stake for this match.
+	if s.label.Stake > 0 {
+		balance, err := walletBalance(ctx, nk, presence.GetUserId())
+		if err != nil {
+			logger.Error("error reading wallet balance: %v", err)
+			return s, false, "wallet unavailable"
+		}
+		if balance < s.label.Stake {
+			logMatchEvent(logger, logEventJoinRejected, map[string]interface{}{"reason": "insufficient funds"})
+			return s, false, "insufficient funds"
+		}
+	}
+
 	// New player attempting to connect.
-	logger.Info("New player attempting to connect.")
+	logger.Debug("New player attempting to connect.")
 	s.joinsInProgress++
 	return s, true, ""
 }
 
 func (m *MatchHandler) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
-	logger.Info("MatchJoin called with %d presences", len(presences))
-
 	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick)
 	t := time.Now().UTC()
 
 	for _, presence := range presences {
-		logger.Info("Player joined: %s", presence.GetUserId())
+		if _, rejoining := s.presences[presence.GetUserId()]; rejoining {
+			logMatchEvent(logger, logEventPlayerRejoined, map[string]interface{}{"user_id": presence.GetUserId(), "playing": s.playing})
+			postMatchEvent(ctx, logger, nk, s, matchEventPlayerReconnected, presence.GetUsername()+" reconnected")
+		} else {
+			logMatchEvent(logger, logEventPlayerJoined, map[string]interface{}{"user_id": presence.GetUserId()})
+			postMatchEvent(ctx, logger, nk, s, matchEventPlayerJoined, presence.GetUsername()+" joined")
+		}
 		s.emptyTicks = 0
 		s.presences[presence.GetUserId()] = presence
 		s.joinsInProgress--
+		delete(s.reserved, presence.GetUserId())
 
 		// Check if we must send a message to this user to update them on the current game state.
 		var opCode api.OpCode
 		var msg proto.Message
 		if s.playing {
 			// There's a game still currently in progress, the player is re-joining after a disconnect. Give them a state update.
-			logger.Info("There's a game still currently in progress, the player is re-joining after a disconnect. Give them a state update.")
 			opCode = api.OpCode_OPCODE_UPDATE
-			msg = &api.Update{
-				Board:    s.board,
-				Mark:     s.mark,
-				Deadline: t.Add(time.Duration(s.deadlineRemainingTicks/tickRate) * time.Second).Unix(),
-			}
+			msg = s.updateMessage(t)
 		} else if s.board != nil && s.marks != nil && s.marks[presence.GetUserId()] > api.Mark_MARK_UNSPECIFIED {
 			// There's no game in progress but we still have a completed game that the user was part of.
 			// They likely disconnected before the game ended, and have since forfeited because they took too long to return.
-			logger.Info("There's no game in progress but we still have a completed game that the user was part of.")
 			opCode = api.OpCode_OPCODE_DONE
-			msg = &api.Done{
-				Board:           s.board,
-				Winner:          s.winner,
-				WinnerPositions: s.winnerPositions,
-			}
+			msg = s.doneMessage()
 		}
 
 		// Send a message to the user that just joined, if one is needed based on the logic above.
@@ -215,27 +413,24 @@ func (m *MatchHandler) MatchJoin(ctx context.Context, logger runtime.Logger, db
 	}
 
 	// Check if match was open to new players, but should now be closed.
-	if len(s.presences) >= 2 && s.label.Open != 0 {
-		s.label.Open = 0
-		if labelJSON, err := json.Marshal(s.label); err != nil {
-			logger.Error("error encoding label: %v", err)
-		} else {
-			if err := dispatcher.MatchLabelUpdate(string(labelJSON)); err != nil {
-				logger.Error("error updating label: %v", err)
-			}
-		}
+	if s.openSeats() <= 0 {
+		setMatchOpen(logger, nk, dispatcher, s, 0)
 	}
 
 	return s
 }
 
 func (m *MatchHandler) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
-	logger.Info("MatchLeave called.")
-
 	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick)
 
 	for _, presence := range presences {
 		s.presences[presence.GetUserId()] = nil
+		logMatchEvent(logger, logEventPlayerLeft, map[string]interface{}{"user_id": presence.GetUserId(), "playing": s.playing})
+		postMatchEvent(ctx, logger, nk, s, matchEventPlayerLeft, presence.GetUsername()+" left")
+		if s.gameNumber == 0 {
+			partyMemberDropped(ctx, logger, nk, s, presence.GetUserId())
+		}
 	}
 
 	var humanPlayersRemaining []runtime.Presence
@@ -255,15 +450,35 @@ func (m *MatchHandler) MatchLeave(ctx context.Context, logger runtime.Logger, db
 }
 
 func (m *MatchHandler) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
-	logger.Debug("MatchLoop called.")
-	var score int64
 	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick)
+
+	if s.closeReason != "" {
+		recordMatchClosed(nk, s, s.closeReason)
+		logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": s.closeReason})
+		return nil
+	}
+
+	if s.terminating {
+		// The server is shutting down, wait out the grace period without starting a new game.
+		return s
+	}
 
 	if s.ConnectedCount()+s.joinsInProgress == 0 {
 		s.emptyTicks++
 		if s.emptyTicks >= maxEmptySec*tickRate {
 			// Match has been empty for too long, close it.
-			logger.Info("Closing idle match")
+			logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": matchCloseIdle})
+			walletSettleStakes(ctx, nk, logger, matchIdFromContext(ctx), s.escrow, nil)
+			if s.playing {
+				// Keep the game, without its refunded stakes, so the players can resume it later.
+				if err := saveGameSnapshot(ctx, nk, matchIdFromContext(ctx), s); err != nil {
+					logger.Error("error saving game snapshot: %v", err)
+				}
+			} else if s.resume != nil {
+				abandonResume(ctx, logger, nk, s)
+			}
+			recordMatchClosed(nk, s, matchCloseIdle)
 			return nil
 		}
 	}
@@ -272,209 +487,206 @@ func (m *MatchHandler) MatchLoop(ctx context.Context, logger runtime.Logger, db
 
 	// If there's no game in progress check if we can (and should) start one!
 	if !s.playing {
+		// Players can chat in the lobby and between games, but moves have to wait for a game to start.
+		for _, message := range messages {
+			msgLogger := logger.WithFields(map[string]interface{}{"user_id": message.GetUserId(), "op_code": message.GetOpCode()})
+			if !m.admitMessage(msgLogger, dispatcher, tick, s, message) || m.handleSocialMessage(msgLogger, dispatcher, tick, s, message) {
+				continue
+			}
+			if api.OpCode(message.GetOpCode()) == api.OpCode_OPCODE_MOVE {
+				msgLogger.Debug("move rejected: no game in progress")
+				m.reject(msgLogger, dispatcher, s, message, api.RejectReason_REJECT_REASON_NOT_YOUR_TURN)
+				continue
+			}
+			msgLogger.Debug("message rejected: unexpected op code")
+			m.reject(msgLogger, dispatcher, s, message, api.RejectReason_REJECT_REASON_UNEXPECTED_OPCODE)
+			m.strike(msgLogger, dispatcher, tick, s, message)
+		}
+
+		// Seats held for players who haven't turned up in time are given up, and their party told.
+		if len(s.reserved) > 0 && tick >= s.reservedUntil {
+			for userID := range s.reserved {
+				logMatchEvent(logger, logEventPlayerLeft, map[string]interface{}{"user_id": userID, "playing": false, "reason": "reservation expired"})
+				delete(s.reserved, userID)
+				partyMemberDropped(ctx, logger, nk, s, userID)
+			}
+		}
+		// A saved game can't carry on without all of its players, so close the match if they don't all come back.
+		if s.resume != nil && tick >= s.resumeUntil {
+			logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": matchCloseResumeExpired})
+			postMatchEvent(ctx, logger, nk, s, matchEventGameAbandoned, "Not every player came back, game saved")
+			abandonResume(ctx, logger, nk, s)
+			recordMatchClosed(nk, s, matchCloseResumeExpired)
+			return nil
+		}
 		// Between games any disconnected users are purged, there's no in-progress game for them to return to anyway.
-		return startNewGame(s, logger, dispatcher, m, t)
+		return startNewGame(ctx, s, logger, nk, dispatcher, m, t)
 	}
 
 	// There's a game in progress. Check for input, update match state, and send messages to clients.
 	for _, message := range messages {
-		logger.Info("Game in progress!")
+		msgLogger := logger.WithFields(map[string]interface{}{"user_id": message.GetUserId(), "op_code": message.GetOpCode()})
+		if !m.admitMessage(msgLogger, dispatcher, tick, s, message) {
+			continue
+		}
+
 		switch api.OpCode(message.GetOpCode()) {
 		case api.OpCode_OPCODE_MOVE:
-			mark := s.marks[message.GetUserId()]
-			if s.mark != mark {
-				// It is not this player's turn.
-				_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_REJECTED), nil, []runtime.Presence{message}, nil, true)
-				continue
-			}
-
 			msg := &api.Move{}
 			err := m.unmarshaler.Unmarshal(message.GetData(), msg)
 			if err != nil {
 				// Client sent bad data.
-				_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_REJECTED), nil, []runtime.Presence{message}, nil, true)
+				msgLogger.Debug("move rejected: bad data")
+				m.reject(msgLogger, dispatcher, s, message, api.RejectReason_REJECT_REASON_INVALID_MESSAGE)
+				m.strike(msgLogger, dispatcher, tick, s, message)
 				continue
 			}
-			if msg.Position < 0 || msg.Position > 8 || s.board[msg.Position] != api.Mark_MARK_UNSPECIFIED {
-				// Client sent a position outside the board, or one that has already been played.
-				_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_REJECTED), nil, []runtime.Presence{message}, nil, true)
+			if turn := s.acceptedTurn(message.GetUserId(), msg.Sequence); turn != 0 {
+				// The client is retrying a move that was already played, acknowledge it again.
+				msgLogger.Debug("duplicate move %v acknowledged", msg.Sequence)
+				m.ackMove(msgLogger, dispatcher, message, msg.Sequence, turn)
 				continue
 			}
-
-			// Update the game state.
-			s.board[msg.Position] = mark
-
-			logger.Info("Position %v marked by %v", msg.Position, mark)
-
-			switch mark {
-			case api.Mark_MARK_X:
-				s.mark = api.Mark_MARK_O
-			case api.Mark_MARK_O:
-				s.mark = api.Mark_MARK_X
+			if msg.Turn != 0 && msg.Turn != s.turn() {
+				// The client made the move against an outdated board.
+				msgLogger.Debug("move rejected: stale turn %v", msg.Turn)
+				m.rejectMove(msgLogger, dispatcher, s, message, msg, api.RejectReason_REJECT_REASON_STALE_MOVE)
+				continue
 			}
-			s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
-			logger.Info("deadlineRemainingTicks=%v", s.deadlineRemainingTicks)
-			// Check if game is over through a winning move.
-		winCheck:
-			for _, winningPosition := range winningPositions {
-				for _, position := range winningPosition {
-					if s.board[position] != mark {
-						continue winCheck
-					}
-				}
 
-				logger.Info("Match won by %v", mark)
-
-				s.winner = mark
-				s.winnerPositions = winningPosition
-				s.playing = false
-				s.deadlineRemainingTicks = 0
+			mark := s.marks[message.GetUserId()]
+			if s.mark != mark || message.GetUserId() != s.currentPlayer() {
+				// It is not this player's turn.
+				msgLogger.Debug("move rejected: not player's turn")
+				m.rejectMove(msgLogger, dispatcher, s, message, msg, api.RejectReason_REJECT_REASON_NOT_YOUR_TURN)
+				continue
 			}
-			// Check if game is over because no more moves are possible.
-			tie := true
-			for _, mark := range s.board {
-				if mark == api.Mark_MARK_UNSPECIFIED {
-					tie = false
-					break
-				}
+			if s.flagged(t) {
+				// The move came in too late, the player loses on time below.
+				msgLogger.Debug("move rejected: out of time")
+				m.rejectMove(msgLogger, dispatcher, s, message, msg, api.RejectReason_REJECT_REASON_OUT_OF_TIME)
+				continue
 			}
-			if tie {
-				logger.Info("Match tied")
-				// Update state to reflect the tie
-				s.board = make([]api.Mark, 9)
-				s.marks = make(map[string]api.Mark, 2)
-				s.playing = false
-				s.deadlineRemainingTicks = 0
+			rules := rulesFor(s.label)
+			move := newMoveRecord(rules, message.GetUserId(), mark, msg)
+			if reason := rules.checkMove(s.board, s.moves, move); reason != api.RejectReason_REJECT_REASON_UNSPECIFIED {
+				// Client sent a position outside the board, one that has already been played, or a move the rules
+				// don't allow this turn.
+				msgLogger.Debug("move rejected: invalid move at %v", msg.Position)
+				m.rejectMove(msgLogger, dispatcher, s, message, msg, reason)
+				continue
 			}
 
-			var opCode api.OpCode
-			var outgoingMsg proto.Message
-			if s.playing {
-				// Keep track of the time remaining for the player to submit their move. Idle players forfeit.
-				s.deadlineRemainingTicks--
-				if s.deadlineRemainingTicks <= 0 && s.label.Fast == 1 {
-					// The player has run out of time to submit their move.
-					s.playing = false
-					switch s.mark {
-					case api.Mark_MARK_X:
-						s.winner = api.Mark_MARK_O
-					case api.Mark_MARK_O:
-						s.winner = api.Mark_MARK_X
-					}
-					s.deadlineRemainingTicks = 0
+			// Update the game state.
+			elapsed := t.Sub(s.turnStartedAt)
+			nk.MetricsTimerRecord(metricMoveLatency, labelTags(s.label), elapsed)
+			move.ElapsedMs = elapsed.Milliseconds()
+			s.pressClock(mark, elapsed)
+			s.turnStartedAt = t
+			over := s.playMove(rules, move)
+			m.ackMove(msgLogger, dispatcher, message, msg.Sequence, int32(len(s.moves)))
 
-					buf, err := m.marshaler.Marshal(&api.Done{
-						Board:           s.board,
-						Winner:          s.winner,
-						WinnerPositions: s.winnerPositions,
-					})
-					if err != nil {
-						logger.Error("error encoding message: %v", err)
-					} else {
-						_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_DONE), buf, nil, nil, true)
-					}
-				} else {
-					opCode = api.OpCode_OPCODE_UPDATE
-					outgoingMsg = &api.Update{
-						Board:    s.board,
-						Mark:     s.mark,
-						Deadline: t.Add(time.Duration(s.deadlineRemainingTicks/tickRate) * time.Second).Unix(),
-					}
-					logger.Info("Deadline=%v", t.Add(time.Duration(s.deadlineRemainingTicks/tickRate)*time.Second).Unix())
-					logger.Info("On going game update message sent")
-				}
-			} else {
-				logger.Info("Sending game round completed message")
-				opCode = api.OpCode_OPCODE_DONE
-				outgoingMsg = &api.Done{
-					Board:           s.board,
-					Winner:          s.winner,
-					WinnerPositions: s.winnerPositions,
-				}
-				logger.Info("Game round completed message sent")
+			msgLogger.Debug("Position %v marked by %v", msg.Position, mark)
+
+			s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
+			if over {
+				s.deadlineRemainingTicks = 0
 			}
 
 			if !s.playing {
-				logger.Info("Match ended.")
-				buf, err := m.marshaler.Marshal(outgoingMsg)
-				if err != nil {
-					logger.Error("error encoding message: %v", err)
-				} else {
-					logger.Info("Broadcasting message %v", int64(opCode))
-					_ = dispatcher.BroadcastMessage(int64(opCode), buf, nil, nil, true)
-				}
+				m.finishGame(ctx, logger, nk, dispatcher, s)
+				recordMatchClosed(nk, s, matchCloseGameOver)
+				logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": matchCloseGameOver})
+				return nil
+			}
 
-				if s.winner != api.Mark_MARK_UNSPECIFIED {
-					var winnerId string
-					var loserId string
-
-					// Set winner id and loser id
-					for userId, mark := range s.marks {
-						if mark == s.winner {
-							winnerId = userId
-						} else {
-							loserId = userId
-						}
-					}
+			m.broadcastUpdate(ctx, logger, nk, dispatcher, s, t)
 
-					// Set score for winning player +100
-					if winnerId != "" {
-						var winnerUsername string
-						if presence, ok := s.presences[winnerId]; ok && presence != nil {
-							winnerUsername = presence.GetUsername()
-						}
+		default:
+			if !m.handleSocialMessage(msgLogger, dispatcher, tick, s, message) {
+				// No other opcodes are expected from the client, so automatically treat it as an error.
+				msgLogger.Debug("message rejected: unexpected op code")
+				m.reject(msgLogger, dispatcher, s, message, api.RejectReason_REJECT_REASON_UNEXPECTED_OPCODE)
+				m.strike(msgLogger, dispatcher, tick, s, message)
+			}
+		}
+	}
 
-						logger.Info("Set score for winning player %v", winnerUsername)
-						score = 100
-						setLeaderboard(ctx, nk, logger, winnerId, winnerUsername, score)
-					}
+	// Keep track of the time remaining for the side to move. A side that runs out of time loses.
+	if s.playing {
+		s.deadlineRemainingTicks--
+		if s.flagged(t) {
+			logger.Debug("mark %v ran out of time", s.mark)
+			if s.timeOut(t) {
+				// Only a single side is left in the game.
+				s.playing = false
+				s.forfeit = true
+				s.deadlineRemainingTicks = 0
+				m.finishGame(ctx, logger, nk, dispatcher, s)
+				recordMatchClosed(nk, s, matchCloseGameOver)
+				logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": matchCloseGameOver})
+				return nil
+			}
+			m.broadcastUpdate(ctx, logger, nk, dispatcher, s, t)
+		}
+	}
 
-					// Set score for losing player -100
-					if loserId != "" {
-						var loserUsername string
-						if presence, ok := s.presences[loserId]; ok && presence != nil {
-							loserUsername = presence.GetUsername()
-						}
+	return s
+}
 
-						logger.Info("Set score for losing player %v", loserUsername)
-						score = -100
-						setLeaderboard(ctx, nk, logger, loserId, loserUsername, score)
-					}
+// Checkpoint the game in progress so it can be resumed if this match is lost, and send everyone its current state.
+func (m *MatchHandler) broadcastUpdate(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, s *MatchState, t time.Time) {
+	if err := saveGameSnapshot(ctx, nk, matchIdFromContext(ctx), s); err != nil {
+		logger.Error("error saving game snapshot: %v", err)
+	}
 
-				} else {
-					// Set score for tie players +10
-					for userId := range s.presences {
-						var username string
-						if presence, ok := s.presences[userId]; ok && presence != nil {
-							username = presence.GetUsername()
-						}
-
-						logger.Info("Set score for tied player %v", username)
-						score = 10
-						setLeaderboard(ctx, nk, logger, userId, username, score)
-					}
-				}
-				return nil
-			}
+	buf, err := m.marshaler.Marshal(s.updateMessage(t))
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+	} else {
+		_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_UPDATE), buf, nil, nil, true)
+	}
+}
 
-			buf, err := m.marshaler.Marshal(outgoingMsg)
-			if err != nil {
-				logger.Error("error encoding message: %v", err)
-			} else {
-				logger.Info("Broadcasting message %v", int64(opCode))
-				_ = dispatcher.BroadcastMessage(int64(opCode), buf, nil, nil, true)
-			}
+// Announce the result of the game that just ended, then settle stakes and record the result against each player.
+func (m *MatchHandler) finishGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, s *MatchState) {
+	settlePlacements(s)
 
-		default:
-			// No other opcodes are expected from the client, so automatically treat it as an error.
-			_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_REJECTED), nil, []runtime.Presence{message}, nil, true)
+	logMatchEvent(logger, logEventGameEnded, map[string]interface{}{"result": gameResult(s), "moves": len(s.moves)})
+	buf, err := m.marshaler.Marshal(s.doneMessage())
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+	} else {
+		_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_DONE), buf, nil, nil, true)
+	}
+
+	// The winner takes the stakes, which are refunded if no one won outright.
+	walletSettleStakes(ctx, nk, logger, matchIdFromContext(ctx), s.escrow, s.winners())
+
+	// Score each player by their place: +100 for a win, -100 for a loss, +10 for a draw, and in between for the
+	// middle places of a multi-player game.
+	outcomes := gameOutcomes(s)
+	ratingDeltas := make(map[string]int64, len(s.marks))
+	for userId := range s.marks {
+		var username string
+		if presence, ok := s.presences[userId]; ok && presence != nil {
+			username = presence.GetUsername()
 		}
+		ratingDeltas[userId] = setLeaderboard(ctx, nk, logger, userId, username, placementScore(s, userId), outcomes[userId])
 	}
 
-	return s
+	writeMatchHistory(ctx, logger, nk, m.marshaler, m.unmarshaler, matchIdFromContext(ctx), s, ratingDeltas)
+	updateAnticheat(ctx, logger, nk, m.marshaler, s)
+	if err := deleteGameSnapshot(ctx, nk, matchIdFromContext(ctx)); err != nil {
+		logger.Error("error deleting game snapshot: %v", err)
+	}
+
+	nk.MetricsCounterAdd(metricGameResults, labelTagsWith(s.label, "result", gameResult(s)), 1)
+	postMatchEvent(ctx, logger, nk, s, matchEventGameOver, gameOverText(s))
+	evaluateAchievements(ctx, logger, nk, dispatcher, m.marshaler, s)
 }
 
-func startNewGame(s *MatchState, logger runtime.Logger, dispatcher runtime.MatchDispatcher, m *MatchHandler, t time.Time) interface{} {
+func startNewGame(ctx context.Context, s *MatchState, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, m *MatchHandler, t time.Time) interface{} {
 	for userID, presence := range s.presences {
 		if presence == nil {
 			delete(s.presences, userID)
@@ -482,46 +694,90 @@ func startNewGame(s *MatchState, logger runtime.Logger, dispatcher runtime.Match
 	}
 
 	// Check if we need to update the label so the match now advertises itself as open to join.
-	if len(s.presences) < 2 && s.label.Open != 1 {
-		s.label.Open = 1
-		if labelJSON, err := json.Marshal(s.label); err != nil {
-			logger.Error("error encoding label: %v", err)
-		} else {
-			if err := dispatcher.MatchLabelUpdate(string(labelJSON)); err != nil {
-				logger.Error("error updating label: %v", err)
-			}
-		}
+	if s.openSeats() > 0 && s.resume == nil {
+		setMatchOpen(logger, nk, dispatcher, s, 1)
 	}
 
 	// Check if we have enough players to start a game.
-	if len(s.presences) < 2 {
+	if len(s.presences) < s.label.playerCount() {
 		return s
 	}
 
-	// We can start a game! Set up the game state and assign the marks to each player.
-	logger.Info("Starting new game!")
-	s.playing = true
-	s.board = make([]api.Mark, 9)
-	s.marks = make(map[string]api.Mark, 2)
-	marks := []api.Mark{api.Mark_MARK_X, api.Mark_MARK_O}
-
+	// Collect the stake from both players before the game begins. A player who can no longer pay is removed.
+	userIDs := make([]string, 0, len(s.presences))
 	for userID := range s.presences {
-		s.marks[userID] = marks[0]
-		marks = marks[1:]
+		userIDs = append(userIDs, userID)
+	}
+	escrow, unpaidUserID := walletEscrowStakes(ctx, nk, logger, matchIdFromContext(ctx), s.label.Stake, userIDs)
+	if unpaidUserID != "" {
+		if err := dispatcher.MatchKick([]runtime.Presence{s.presences[unpaidUserID]}); err != nil {
+			logger.Error("error kicking player: %v", err)
+		}
+		return s
+	}
+	s.escrow = escrow
+
+	if s.resume != nil {
+		// All the players from a lost match are back, pick their game up where it left off.
+		resumeGame(ctx, logger, nk, s, t)
+	} else {
+		// We can start a game! Set up the game state and assign the marks to each player.
+		s.gameNumber++
+		s.playing = true
+		s.board = newBoard(rulesFor(s.label).shape())
+		s.moves = nil
+		s.assignMarks()
+		s.mark = api.Mark_MARK_X
+		s.winner = api.Mark_MARK_UNSPECIFIED
+		s.winnerPositions = nil
+		s.placements = make(map[api.Mark]int32, len(s.sides()))
+		s.forfeit = false
+		s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
+		s.clocks = newClocks(s.label, s.sides())
+		s.turnStartedAt = t
+		s.gameStartedAt = t
+		nk.MetricsCounterAdd(metricGamesStarted, labelTags(s.label), 1)
+		logMatchEvent(logger.WithField("game", s.gameNumber), logEventGameStarted, map[string]interface{}{"players": len(s.marks)})
+
+		postMatchEvent(ctx, logger, nk, s, matchEventGameStarted, "New game started")
+	}
+
+	// Look up everyone's equipped cosmetics so each client can draw the opponent's skin.
+	cosmetics, err := readCosmeticSelections(ctx, nk, userIDs)
+	if err != nil {
+		logger.Error("error reading cosmetics: %v", err)
 	}
-	s.mark = api.Mark_MARK_X
-	s.winner = api.Mark_MARK_UNSPECIFIED
-	s.winnerPositions = nil
-	s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
 
 	// Notify the players a new game has started.
-	logger.Info("Notify the players a new game has started")
-	buf, err := m.marshaler.Marshal(&api.Start{
-		Board:    s.board,
-		Marks:    s.marks,
-		Mark:     s.mark,
-		Deadline: t.Add(time.Duration(s.deadlineRemainingTicks/tickRate) * time.Second).Unix(),
-	})
+	start := &api.Start{
+		Board:      s.board,
+		Marks:      s.marks,
+		Mark:       s.mark,
+		Deadline:   s.deadline(t).Unix(),
+		Cosmetics:  cosmetics,
+		ChannelId:  s.channelID,
+		Turn:       s.turn(),
+		Mode:       api.GameMode(s.label.Mode),
+		Variant:    api.Variant(s.label.Variant),
+		Shape:      rulesFor(s.label).shape(),
+		WinRule:    api.WinRule(s.label.WinRule),
+		TurnUserId: s.currentPlayer(),
+		Rotations:  s.rotationsMessage(),
+
+		TimeControl: api.TimeControl(s.label.TimeControl),
+		Clocks:      s.clocksMessage(t),
+	}
+	if control, ok := timeControls[start.TimeControl]; ok {
+		start.IncrementMs = control.increment.Milliseconds()
+		start.IncrementMode = control.mode
+	}
+	if start.Mode == api.GameMode_GAME_MODE_ULTIMATE {
+		start.ActiveBoard, start.SubBoardWinners = ultimateState(s.board, s.moves)
+	}
+	if start.Variant == api.Variant_VARIANT_NUMERICAL {
+		start.Values = numericalValues(len(s.board), s.moves)
+	}
+	buf, err := m.marshaler.Marshal(start)
 	if err != nil {
 		logger.Error("error encoding message: %v", err)
 	} else {
@@ -530,16 +786,25 @@ func startNewGame(s *MatchState, logger runtime.Logger, dispatcher runtime.Match
 	return s
 }
 
+// Apply a game's score to a player's leaderboard record, returning the change actually made to their score. The
+// win, loss and draw counts follow the player's outcome rather than the score, so a middle place in a multi-player
+// game counts as a loss, as it does everywhere else.
 func setLeaderboard(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger,
-	userId string, userName string, score int64) {
+	userId string, userName string, score int64, outcome playerOutcome) int64 {
 
 	var metadata map[string]interface{}
-	var calcScore int64
+	var calcScore, previousScore int64
 	var err error
 
-	logger.Info("Setting leaderboard metadata")
-	metadata, calcScore, err = getLeaderboardMetadata(ctx, nk, logger, userId, score)
-	logger.Info("Fetched userName = %v metadata = %v", userName, metadata)
+	logger = logger.WithField("user_id", userId)
+	if excluded, err := anticheatExcluded(ctx, nk, userId); err != nil {
+		logger.Error("error reading anti-cheat profile: %v", err)
+	} else if excluded {
+		logger.Debug("account excluded from leaderboards")
+		return 0
+	}
+
+	metadata, calcScore, previousScore, err = getLeaderboardMetadata(ctx, nk, logger, userId, score)
 
 	if metadata == nil {
 		metadata = map[string]interface{}{
@@ -551,43 +816,39 @@ func setLeaderboard(ctx context.Context, nk runtime.NakamaModule, logger runtime
 
 	if err == nil {
 		// Only update wins if it's a win condition
-		if score == 100 {
-			logger.Info("Setting win condition")
+		if outcome == outcomeWin {
 			switch v := metadata["wins"].(type) {
 			case int:
 				metadata["wins"] = metadata["wins"].(int) + 1
 			case float64:
 				metadata["wins"] = metadata["wins"].(float64) + 1
 			default:
-				logger.Info("metadata wins type = %v", v)
+				logger.Warn("unexpected metadata wins type %T", v)
 			}
-			logger.Info("metadata wins after setting: %v", metadata["wins"])
 		}
 
 		// Only update losses if it's a loss condition
-		if score == -100 {
+		if outcome == outcomeLoss {
 			switch v := metadata["losses"].(type) {
 			case int:
 				metadata["losses"] = metadata["losses"].(int) + 1
 			case float64:
 				metadata["losses"] = metadata["losses"].(float64) + 1
 			default:
-				logger.Info("metadata losses type = %v", v)
+				logger.Warn("unexpected metadata losses type %T", v)
 			}
-			logger.Info("metadata losses after setting: %v", metadata["losses"])
 		}
 
 		// Handle draw condition
-		if score == 10 {
+		if outcome == outcomeDraw {
 			switch v := metadata["draws"].(type) {
 			case int:
 				metadata["draws"] = metadata["draws"].(int) + 1
 			case float64:
 				metadata["draws"] = metadata["draws"].(float64) + 1
 			default:
-				logger.Info("metadata draws type = %v", v)
+				logger.Warn("unexpected metadata draws type %T", v)
 			}
-			logger.Info("metadata draws after setting: %v", metadata["draws"])
 		}
 	}
 
@@ -597,19 +858,22 @@ func setLeaderboard(ctx context.Context, nk runtime.NakamaModule, logger runtime
 		score = 0
 	}
 
-	writeLeaderboard(ctx, nk, logger, userId, userName, score, metadata)
+	if !writeLeaderboard(ctx, nk, logger, userId, userName, score, metadata) {
+		return 0
+	}
+	return score - previousScore
 }
 
 func getLeaderboardMetadata(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger,
-	userId string, score int64) (map[string]interface{}, int64, error) {
-	logger.Info("Getting the leaderboard for userId: %v", userId)
+	userId string, score int64) (map[string]interface{}, int64, int64, error) {
+	var previousScore int64
 	metadata := map[string]interface{}{
 		"wins":   0,
 		"losses": 0,
 		"draws":  0,
 	}
 
-	leaderboard, err := nk.LeaderboardRecordsHaystack(ctx, "xoxo_leaderboard", userId, 2, "", 0)
+	leaderboard, err := nk.LeaderboardRecordsHaystack(ctx, leaderboardId, userId, 2, "", 0)
 
 	if err != nil {
 		logger.Error("error getting leaderboard for user: %v", err)
@@ -617,19 +881,17 @@ func getLeaderboardMetadata(ctx context.Context, nk runtime.NakamaModule, logger
 		// Unmarshal the leaderboard to metadata
 		if len(leaderboard.Records) > 0 {
 			for _, entry := range leaderboard.Records {
-				logger.Info("entry.OwnerId: %v", entry.OwnerId)
-				logger.Info("entry.Metadata, %v", entry.Metadata)
-
+				if entry.OwnerId == userId {
+					previousScore = entry.Score
+				}
 				if entry.Metadata != "" && entry.OwnerId == userId {
-					logger.Info("Unmarshalling the leaderboard metadata")
 					err = json.Unmarshal([]byte(entry.Metadata), &metadata)
 					if err != nil {
-						logger.Info("error unmarshalling metadata for user: %v", err)
+						logger.Warn("error unmarshalling leaderboard metadata: %v", err)
 						metadata = nil
 					}
 
 					var absScore = int64(math.Abs(float64(score)))
-					logger.Info("absScore: %v", absScore)
 
 					if entry.Score > absScore {
 						score += entry.Score
@@ -637,44 +899,82 @@ func getLeaderboardMetadata(ctx context.Context, nk runtime.NakamaModule, logger
 				}
 			}
 		} else {
-			logger.Info("No data in metadata")
+			logger.Debug("no existing leaderboard record")
 		}
 	}
 
-	logger.Info("fetched metadata: %v", metadata)
-	return metadata, score, err
+	return metadata, score, previousScore, err
 }
 
+// Replace a player's leaderboard record, reporting whether it was written.
 func writeLeaderboard(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger, userId string,
-	userName string, score int64, metadata map[string]interface{}) {
-	logger.Info("Writing leaderboard metadata")
-	logger.Info("metadata: %v", metadata)
-
+	userName string, score int64, metadata map[string]interface{}) bool {
 	var err error
 
-	err = nk.LeaderboardRecordDelete(ctx, "xoxo_leaderboard", userId)
+	err = nk.LeaderboardRecordDelete(ctx, leaderboardId, userId)
 	if err != nil {
-		logger.Info("error deleting leaderboard entry for user: %v", err)
+		logger.Warn("error deleting leaderboard entry: %v", err)
 	}
 
-	logger.Info("deleted leaderboard entry for user: %v", userName)
-
-	_, err = nk.LeaderboardRecordWrite(ctx, "xoxo_leaderboard", userId, userName, score, 0, metadata, nil)
+	_, err = nk.LeaderboardRecordWrite(ctx, leaderboardId, userId, userName, score, 0, metadata, nil)
 	if err != nil {
-		logger.Info("error writing leaderboard for user: %v", err)
+		logger.Error("error writing leaderboard entry: %v", err)
+		return false
 	}
 
-	logger.Info("added leaderboard entry for user: %v", userName)
+	logMatchEvent(logger, logEventLeaderboardUpdated, map[string]interface{}{"score": score})
+
+	writeCountryLeaderboard(ctx, nk, logger, userId, userName, score, metadata)
+	return true
 }
 
 func (m *MatchHandler) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
-	return state, ""
+	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick)
+	return s, m.handleAdminSignal(ctx, logger, nk, dispatcher, tick, s, data)
 }
 
 func (m *MatchHandler) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	s := state.(*MatchState)
+	logger = matchLogger(ctx, logger, s, tick)
+	m.abandonGame(ctx, logger, nk, dispatcher, s, graceSeconds)
+
+	// Stop advertising the match so no one new joins while it winds down.
+	s.terminating = true
+	setMatchOpen(logger, nk, dispatcher, s, 0)
+
+	recordMatchClosed(nk, s, matchCloseTerminated)
+	logMatchEvent(logger, logEventMatchClosed, map[string]interface{}{"reason": matchCloseTerminated, "grace_seconds": graceSeconds})
 	return state
 }
 
+// Update whether the match advertises itself as open to join, keeping the open matches gauge in step.
+func setMatchOpen(logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, s *MatchState, open int) {
+	if s.label.Open == open {
+		return
+	}
+	s.label.Open = open
+	if open == 1 {
+		addOpenMatches(nk, s.label, 1)
+	} else {
+		addOpenMatches(nk, s.label, -1)
+	}
+
+	if labelJSON, err := json.Marshal(s.label); err != nil {
+		logger.Error("error encoding label: %v", err)
+	} else if err := dispatcher.MatchLabelUpdate(string(labelJSON)); err != nil {
+		logger.Error("error updating label: %v", err)
+	}
+}
+
+// The speed the match is played at, "fast" or "normal", as reported in metrics and recorded in match history.
+func (l *MatchLabel) speed() string {
+	if l.Fast == 1 {
+		return matchSpeedFast
+	}
+	return matchSpeedNormal
+}
+
 func calculateDeadlineTicks(l *MatchLabel) int64 {
 	if l.Fast == 1 {
 		return turnTimeFastSec * tickRate
@@ -682,3 +982,127 @@ func calculateDeadlineTicks(l *MatchLabel) int64 {
 		return turnTimeNormalSec * tickRate
 	}
 }
+
+func matchIdFromContext(ctx context.Context) string {
+	matchID, _ := ctx.Value(runtime.RUNTIME_CTX_MATCH_ID).(string)
+	return matchID
+}
+
+// Build the record of a move sent by a player, working out the position from its coordinates if it has them.
+func newMoveRecord(rules gameRules, userID string, mark api.Mark, msg *api.Move) *moveRecord {
+	if msg.Coordinates != nil {
+		msg.Position = coordinatesPosition(rules.shape(), msg.Coordinates)
+	}
+	move := &moveRecord{
+		UserID:   userID,
+		Mark:     mark,
+		Position: msg.Position,
+		Piece:    mark,
+		Value:    msg.Value,
+		Sequence: msg.Sequence,
+	}
+	if msg.Mark != api.Mark_MARK_UNSPECIFIED {
+		move.Piece = msg.Mark
+	}
+	return move
+}
+
+// Place a move the rules allow and pass the turn on. Reports whether the game is over, in which case the winner is set.
+func (s *MatchState) playMove(rules gameRules, move *moveRecord) bool {
+	s.board[move.Position] = move.Piece
+	s.moves = append(s.moves, move)
+
+	// Check if game is over through a winning move, or because no more moves are possible.
+	over, winner, positions := rules.result(s.board, s.moves, move)
+	if over && winner != api.Mark_MARK_UNSPECIFIED && s.eliminating() {
+		// The player drops out with their place, and the rest play on for the places left.
+		over = s.finishSide(move.Mark, positions)
+		winner, positions = api.Mark_MARK_UNSPECIFIED, s.winnerPositions
+	}
+	s.mark = s.nextMark()
+	if over {
+		s.winner = winner
+		s.winnerPositions = positions
+		s.playing = false
+	}
+	return over
+}
+
+// The number of the next turn to be played in the current game, starting at 1.
+func (s *MatchState) turn() int32 {
+	return int32(len(s.moves) + 1)
+}
+
+// The turn on which the player's move with this client sequence number was accepted in the current game, or zero if
+// there is no such move.
+func (s *MatchState) acceptedTurn(userID string, sequence int64) int32 {
+	if sequence == 0 {
+		return 0
+	}
+	for i, move := range s.moves {
+		if move.UserID == userID && move.Sequence == sequence {
+			return int32(i + 1)
+		}
+	}
+	return 0
+}
+
+// Acknowledge an accepted move to the player who made it. Moves without a client sequence number are not acknowledged.
+func (m *MatchHandler) ackMove(logger runtime.Logger, dispatcher runtime.MatchDispatcher, presence runtime.Presence, sequence int64, turn int32) {
+	if sequence == 0 {
+		return
+	}
+	buf, err := m.marshaler.Marshal(&api.MoveAck{
+		Sequence: sequence,
+		Turn:     turn,
+	})
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+		return
+	}
+	_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_MOVE_ACK), buf, []runtime.Presence{presence}, nil, true)
+}
+
+// The current state of the game in progress.
+func (s *MatchState) updateMessage(t time.Time) *api.Update {
+	update := &api.Update{
+		Board:      s.board,
+		Mark:       s.mark,
+		Deadline:   s.deadline(t).Unix(),
+		Turn:       s.turn(),
+		Shape:      rulesFor(s.label).shape(),
+		Placements: s.userPlacements(),
+		TurnUserId: s.currentPlayer(),
+		Clocks:     s.clocksMessage(t),
+	}
+	if api.GameMode(s.label.Mode) == api.GameMode_GAME_MODE_ULTIMATE {
+		update.ActiveBoard, update.SubBoardWinners = ultimateState(s.board, s.moves)
+	}
+	if api.Variant(s.label.Variant) == api.Variant_VARIANT_NUMERICAL {
+		update.Values = numericalValues(len(s.board), s.moves)
+	}
+	return update
+}
+
+// The result of the game that just finished.
+func (s *MatchState) doneMessage() *api.Done {
+	done := &api.Done{
+		Board:           s.board,
+		Winner:          s.winner,
+		WinnerPositions: s.winnerPositions,
+		WinnerIds:       s.winners(),
+		Variant:         api.Variant(s.label.Variant),
+		Shape:           rulesFor(s.label).shape(),
+		Placements:      s.userPlacements(),
+	}
+	if len(done.WinnerIds) == 1 {
+		done.WinnerId = done.WinnerIds[0]
+	}
+	if api.GameMode(s.label.Mode) == api.GameMode_GAME_MODE_ULTIMATE {
+		done.SubBoardWinners = ultimateSubBoardWinners(s.board)
+	}
+	if done.Variant == api.Variant_VARIANT_NUMERICAL {
+		done.Values = numericalValues(len(s.board), s.moves)
+	}
+	return done
+}
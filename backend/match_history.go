@@ -0,0 +1,322 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	matchHistoryCollection = "match_history"
+	headToHeadCollection   = "head_to_head"
+	// Attempts at updating head-to-head totals when a concurrent game changes them first.
+	headToHeadWriteAttempts = 3
+	// Replays are system-owned and publicly readable, keyed by match ID.
+	matchReplayCollection = "match_replays"
+
+	matchHistoryDefaultLimit = 20
+	matchHistoryMaxLimit     = 100
+	// Filtered listings skip entries that don't match, so stop after this many storage pages to bound the work.
+	matchHistoryMaxPages = 10
+)
+
+// The moves of a finished game, enough to play it back.
+type matchReplay struct {
+	MatchID   string              `json:"match_id"`
+	Mode      api.GameMode        `json:"mode"`
+	Variant   api.Variant         `json:"variant"`
+	Players   int                 `json:"players,omitempty"`
+	Marks     map[string]api.Mark `json:"marks"`
+	Usernames map[string]string   `json:"usernames"`
+	Moves     []*moveRecord       `json:"moves"`
+	Winner    api.Mark            `json:"winner"`
+}
+
+// History keys sort newest first, since storage lists objects in key order.
+func matchHistoryKey(end time.Time, matchID string) string {
+	return fmt.Sprintf("%019d_%s", math.MaxInt64-end.UnixMilli(), matchID)
+}
+
+// Record the game that just finished in each player's history, along with the change it made to their leaderboard
+// score, and save its replay.
+func writeMatchHistory(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions, matchID string, s *MatchState, ratingDeltas map[string]int64) {
+	end := time.Now()
+
+	usernames := make(map[string]string, len(s.marks))
+	for userID := range s.marks {
+		if presence := s.presences[userID]; presence != nil {
+			usernames[userID] = presence.GetUsername()
+		}
+	}
+
+	replay, err := json.Marshal(&matchReplay{
+		MatchID:   matchID,
+		Mode:      api.GameMode(s.label.Mode),
+		Variant:   api.Variant(s.label.Variant),
+		Players:   len(s.marks),
+		Marks:     s.marks,
+		Usernames: usernames,
+		Moves:     s.moves,
+		Winner:    s.winner,
+	})
+	if err != nil {
+		logger.Error("error encoding replay: %v", err)
+		return
+	}
+	writes := []*runtime.StorageWrite{
+		{
+			Collection:      matchReplayCollection,
+			Key:             matchID,
+			Value:           string(replay),
+			PermissionRead:  2, // Public read
+			PermissionWrite: 0, // Only server can write
+		},
+	}
+
+	outcomes := gameOutcomes(s)
+	for userID, outcome := range outcomes {
+		entry := &api.MatchHistoryEntry{
+			MatchId:     matchID,
+			Mark:        s.marks[userID],
+			Result:      outcome.toApi(),
+			Forfeit:     s.forfeit,
+			Speed:       s.label.speed(),
+			Stake:       s.label.Stake,
+			StartTime:   s.gameStartedAt.Unix(),
+			EndTime:     end.Unix(),
+			DurationMs:  end.Sub(s.gameStartedAt).Milliseconds(),
+			RatingDelta: ratingDeltas[userID],
+			ReplayKey:   matchID,
+			GameMode:    api.GameMode(s.label.Mode),
+			Variant:     api.Variant(s.label.Variant),
+			Players:     int32(len(s.marks)),
+		}
+		entry.Placement, _ = s.placement(userID)
+		for opponentID, mark := range s.marks {
+			if mark != s.marks[userID] {
+				entry.OpponentIds = append(entry.OpponentIds, opponentID)
+			}
+		}
+		entry.TeammateIds = s.teammates(userID)
+		if len(entry.OpponentIds) == 1 {
+			entry.OpponentId = entry.OpponentIds[0]
+			entry.OpponentUsername = usernames[entry.OpponentId]
+		}
+
+		value, err := marshaler.Marshal(entry)
+		if err != nil {
+			logger.Error("error encoding match history: %v", err)
+			continue
+		}
+		writes = append(writes, &runtime.StorageWrite{
+			Collection:      matchHistoryCollection,
+			Key:             matchHistoryKey(end, matchID),
+			UserID:          userID,
+			Value:           string(value),
+			PermissionRead:  1, // Owner read
+			PermissionWrite: 0, // Only server can write
+		})
+
+		if entry.OpponentId != "" {
+			// The totals are written against the version read, so retry if another game finished in between.
+			var err error
+			for attempt := 0; attempt < headToHeadWriteAttempts; attempt++ {
+				if err = updateHeadToHead(ctx, nk, marshaler, unmarshaler, userID, entry.OpponentId, outcome); err == nil {
+					break
+				}
+			}
+			if err != nil {
+				logger.Error("error updating head-to-head for %v: %v", userID, err)
+			}
+		}
+	}
+
+	if _, err := nk.StorageWrite(ctx, writes); err != nil {
+		logger.Error("error writing match history: %v", err)
+	}
+}
+
+func updateHeadToHead(ctx context.Context, nk runtime.NakamaModule, marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions, userID, opponentID string, outcome playerOutcome) error {
+	totals, version, err := readHeadToHead(ctx, nk, unmarshaler, userID, opponentID)
+	if err != nil {
+		return err
+	}
+
+	switch outcome {
+	case outcomeWin:
+		totals.Wins++
+	case outcomeLoss:
+		totals.Losses++
+	case outcomeDraw:
+		totals.Draws++
+	}
+
+	value, err := marshaler.Marshal(totals)
+	if err != nil {
+		return err
+	}
+	_, err = nk.StorageWrite(ctx, []*runtime.StorageWrite{
+		{
+			Collection:      headToHeadCollection,
+			Key:             opponentID,
+			UserID:          userID,
+			Value:           string(value),
+			Version:         version,
+			PermissionRead:  1, // Owner read
+			PermissionWrite: 0, // Only server can write
+		},
+	})
+	return err
+}
+
+func readHeadToHead(ctx context.Context, nk runtime.NakamaModule, unmarshaler *protojson.UnmarshalOptions, userID, opponentID string) (*api.HeadToHead, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: headToHeadCollection,
+			Key:        opponentID,
+			UserID:     userID,
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	totals := &api.HeadToHead{OpponentId: opponentID}
+	version := "*"
+	if len(objects) > 0 {
+		if err := unmarshaler.Unmarshal([]byte(objects[0].Value), totals); err != nil {
+			return nil, "", err
+		}
+		version = objects[0].Version
+	}
+	return totals, version, nil
+}
+
+func (o playerOutcome) toApi() api.GameResult {
+	switch o {
+	case outcomeWin:
+		return api.GameResult_GAME_RESULT_WIN
+	case outcomeLoss:
+		return api.GameResult_GAME_RESULT_LOSS
+	case outcomeDraw:
+		return api.GameResult_GAME_RESULT_DRAW
+	default:
+		return api.GameResult_GAME_RESULT_UNSPECIFIED
+	}
+}
+
+func matchHistoryFilter(request *api.RpcMatchHistoryRequest, entry *api.MatchHistoryEntry) bool {
+	if request.OpponentId != "" && !slices.Contains(entry.OpponentIds, request.OpponentId) && entry.OpponentId != request.OpponentId {
+		return false
+	}
+	if request.Speed != "" && entry.Speed != request.Speed {
+		return false
+	}
+	if request.Result != api.GameResult_GAME_RESULT_UNSPECIFIED && entry.Result != request.Result {
+		return false
+	}
+	if len(request.GameModes) > 0 && !slices.Contains(request.GameModes, entry.GameMode) {
+		return false
+	}
+	if len(request.Variants) > 0 && !slices.Contains(request.Variants, entry.Variant) {
+		return false
+	}
+	return true
+}
+
+// List the caller's finished games, most recent first, optionally filtered by opponent, speed, result, game mode and
+// variant.
+func rpcListMatchHistory(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcMatchHistoryRequest{}
+		if payload != "" {
+			if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+				return "", errUnmarshal
+			}
+		}
+		if _, ok := api.GameResult_name[int32(request.Result)]; !ok {
+			return "", errInvalidInput
+		}
+
+		limit := int(request.Limit)
+		if limit <= 0 {
+			limit = matchHistoryDefaultLimit
+		} else if limit > matchHistoryMaxLimit {
+			limit = matchHistoryMaxLimit
+		}
+
+		response := &api.RpcMatchHistoryResponse{
+			Entries: make([]*api.MatchHistoryEntry, 0, limit),
+		}
+		cursor := request.Cursor
+		for page := 0; page < matchHistoryMaxPages && len(response.Entries) < limit; page++ {
+			objects, nextCursor, err := nk.StorageList(ctx, "", userID, matchHistoryCollection, limit-len(response.Entries), cursor)
+			if err != nil {
+				logger.Error("error listing match history: %v", err)
+				return "", errInternalError
+			}
+			for _, object := range objects {
+				entry := &api.MatchHistoryEntry{}
+				if err := unmarshaler.Unmarshal([]byte(object.Value), entry); err != nil {
+					logger.Warn("error decoding match history entry %v: %v", object.Key, err)
+					continue
+				}
+				// Entries recorded before the speed field kept it in mode.
+				if entry.Speed == "" {
+					entry.Speed, entry.Mode = entry.Mode, ""
+				}
+				if matchHistoryFilter(request, entry) {
+					response.Entries = append(response.Entries, entry)
+				}
+			}
+			cursor = nextCursor
+			if cursor == "" {
+				break
+			}
+		}
+		response.Cursor = cursor
+
+		if request.OpponentId != "" {
+			totals, _, err := readHeadToHead(ctx, nk, unmarshaler, userID, request.OpponentId)
+			if err != nil {
+				logger.Error("error reading head-to-head: %v", err)
+				return "", errInternalError
+			}
+			response.HeadToHead = totals
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
@@ -28,12 +28,10 @@ import (
 
 type nakamaRpcFunc func(context.Context, runtime.Logger, *sql.DB, runtime.NakamaModule, string) (string, error)
 
-var fast int
-var matchTypeKey string
-
 func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
 	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
 		logger.Info("Entered rpcFindMatch")
+		start := time.Now()
 
 		matchIDs := make([]string, 0, 10)
 
@@ -47,13 +45,44 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 			return "Error unmarshalling the payload", errUnmarshal
 		}
 
-		if request.Fast {
-			fast = 1
+		label, err := matchCriteria(request)
+		if err != nil {
+			return "", err
+		}
+		if request.Stake > 0 {
+			balance, err := walletBalance(ctx, nk, userID)
+			if err != nil {
+				logger.Error("error reading wallet balance: %v", err)
+				return "", errInternalError
+			}
+			if balance < request.Stake {
+				return "", errInsufficientFunds
+			}
+		}
+
+		// A party always gets a match of its own, with seats held for its members.
+		if request.PartyId != "" {
+			matchID, err := createPartyMatch(ctx, logger, nk, userID, request, label)
+			if err != nil {
+				return "", err
+			}
+			nk.MetricsTimerRecord(metricFindMatchLatency, labelTagsWith(label, "outcome", "party"), time.Since(start))
+
+			response, err := marshaler.Marshal(&api.RpcFindMatchResponse{MatchIds: []string{matchID}})
+			if err != nil {
+				logger.Error("error marshaling response payload: %v", err.Error())
+				return "", errMarshal
+			}
+			return string(response), nil
 		}
 
 		// Two-step process: First look for matches, then create if needed
 		// Look for existing matches first
-		query := fmt.Sprintf("+label.open:1 +label.fast:%d", fast)
+		query := fmt.Sprintf("+label.open:1 +label.fast:%d +label.stake:%d +label.mode:%d +label.variant:%d +label.players:%d +label.win_rule:%d +label.team_size:%d +label.time_control:%d", label.Fast, label.Stake, label.Mode, label.Variant, label.Players, label.WinRule, label.TeamSize, label.TimeControl)
+		outcome := "joined"
+
+		// Generate a consistent key for this match type
+		matchTypeKey := fmt.Sprintf("match_lock_fast_%d_stake_%d_mode_%d_variant_%d_players_%d_%d_%d_time_%d", label.Fast, label.Stake, label.Mode, label.Variant, label.Players, label.WinRule, label.TeamSize, label.TimeControl)
 
 		// Try finding a match first - most of the time this will succeed
 		matches, err := nk.MatchList(ctx, 10, true, "", nil, nil, query)
@@ -66,10 +95,6 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 			logger.Info("Found an existing match to join")
 			matchIDs = append(matchIDs, matches[0].MatchId) // Join the first available match
 		} else {
-
-			// Generate a consistent key for this match type
-			matchTypeKey := fmt.Sprintf("match_lock_fast_%d", fast)
-
 			// Use a counter approach - each player tries to increment a counter
 			// Player who gets the counter at 1 creates the match
 
@@ -122,7 +147,8 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 			// If write was successful and we got counter = 1, we create the match
 			if err == nil && len(writeResult) > 0 && counter == 1 {
 				logger.Info("Creating new match as first requester")
-				matchID, err := nk.MatchCreate(ctx, moduleName, map[string]interface{}{"fast": fast})
+				outcome = "created"
+				matchID, err := nk.MatchCreate(ctx, moduleName, matchParams(label))
 				if err != nil {
 					logger.Error("error creating match: %v", err)
 					return "", errInternalError
@@ -142,11 +168,15 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 
 				if len(matches) > 0 {
 					logger.Info("Found match on retry")
+					outcome = "joined_retry"
 					matchIDs = append(matchIDs, matches[0].MatchId)
 				} else {
 					// Still no match, create as fallback
 					logger.Info("No match found after waiting, creating fallback")
-					matchID, err := nk.MatchCreate(ctx, moduleName, map[string]interface{}{"fast": fast})
+					// Another player may be creating a match for the same request at the same time.
+					outcome = "fallback"
+					nk.MetricsCounterAdd(metricFindMatchDuplicates, labelTags(label), 1)
+					matchID, err := nk.MatchCreate(ctx, moduleName, matchParams(label))
 					if err != nil {
 						logger.Error("error creating fallback match: %v", err)
 						return "", errInternalError
@@ -156,6 +186,8 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 			}
 		}
 
+		nk.MetricsTimerRecord(metricFindMatchLatency, labelTagsWith(label, "outcome", outcome), time.Since(start))
+
 		response, err := marshaler.Marshal(&api.RpcFindMatchResponse{MatchIds: matchIDs})
 		if err != nil {
 			logger.Error("error marshaling response payload: %v", err.Error())
@@ -164,7 +196,7 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 
 		// Reset the counter after a delay
 		// This allows the counter to be reused for future match creation
-		if fast == 1 {
+		if label.Fast == 1 {
 			// For fast matches, reset counter more quickly
 			go func() {
 				time.Sleep(5 * time.Second)
@@ -182,6 +214,72 @@ func rpcFindMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.Un
 	}
 }
 
+// Check the criteria of a find match request, and build the label of a match that fits them.
+func matchCriteria(request *api.RpcFindMatchRequest) (*MatchLabel, error) {
+	if request.Stake < 0 || request.Stake > walletMaxStake {
+		return nil, errInvalidInput
+	}
+	if _, ok := api.GameMode_name[int32(request.GameMode)]; !ok {
+		return nil, errInvalidInput
+	}
+	if _, ok := api.Variant_name[int32(request.Variant)]; !ok || !variantAllowed(request.GameMode, request.Variant) {
+		return nil, errInvalidInput
+	}
+	if _, ok := api.TimeControl_name[int32(request.TimeControl)]; !ok {
+		return nil, errInvalidInput
+	}
+	if _, ok := api.PartyPlacement_name[int32(request.PartyPlacement)]; !ok {
+		return nil, errInvalidInput
+	}
+	players, winRule, teamSize := int(request.Players), int(request.WinRule), 1
+	if players == 0 {
+		players = minPlayers
+	}
+	if request.Teams {
+		if request.Players != 0 || request.WinRule != 0 {
+			return nil, errInvalidInput
+		}
+		players, teamSize = 2*playersPerTeam, playersPerTeam
+	}
+	if _, ok := api.WinRule_name[int32(winRule)]; !ok || !playersAllowed(request.GameMode, request.Variant, players/teamSize) {
+		return nil, errInvalidInput
+	}
+	if players/teamSize == minPlayers {
+		// The win rule makes no difference to games between two sides, so don't split them up over it.
+		winRule = 0
+	}
+
+	label := &MatchLabel{
+		Stake:    request.Stake,
+		Mode:     int(request.GameMode),
+		Variant:  int(request.Variant),
+		Players:  players,
+		WinRule:  winRule,
+		TeamSize: teamSize,
+
+		TimeControl: int(request.TimeControl),
+	}
+	if request.Fast {
+		label.Fast = 1
+	}
+	return label, nil
+}
+
+// Parameters to create a match with the given label.
+func matchParams(label *MatchLabel) map[string]interface{} {
+	return map[string]interface{}{
+		"fast":      label.Fast,
+		"stake":     label.Stake,
+		"mode":      label.Mode,
+		"variant":   label.Variant,
+		"players":   label.Players,
+		"win_rule":  label.WinRule,
+		"team_size": label.TeamSize,
+
+		"time_control": label.TimeControl,
+	}
+}
+
 // Helper function to delete the counter
 func deleteCounter(nk runtime.NakamaModule, key string) {
 	ctx := context.Background()
@@ -0,0 +1,384 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Games in progress are checkpointed to system-owned storage, keyed by match ID.
+	gameSnapshotCollection = "game_snapshots"
+
+	// How long a saved game can wait to be resumed before it is discarded.
+	gameSnapshotTTL             = 24 * time.Hour
+	gameSnapshotExpireBatchSize = 100
+
+	// How long a match resuming a saved game waits for all its players to join.
+	resumeJoinSec = 60
+)
+
+// A game in progress, saved after every move so it can be resumed in a new match if its own match is lost.
+type gameSnapshot struct {
+	MatchID   string              `json:"match_id"`
+	Label     *MatchLabel         `json:"label"`
+	Board     []api.Mark          `json:"board"`
+	Marks     map[string]api.Mark `json:"marks"`
+	Usernames map[string]string   `json:"usernames"`
+	Mark      api.Mark            `json:"mark"`
+	Moves     []*moveRecord       `json:"moves"`
+	// The players sharing each mark, in turn order.
+	Rotation map[api.Mark][]string `json:"rotation,omitempty"`
+	// Places already decided in an elimination game.
+	Placements map[api.Mark]int32 `json:"placements,omitempty"`
+	// Ticks the player to move had left when the snapshot was saved.
+	DeadlineRemainingTicks int64 `json:"deadline_remaining_ticks"`
+	// Milliseconds left on each side's clock when the snapshot was saved, if there is a time control.
+	Clocks     map[api.Mark]int64 `json:"clocks,omitempty"`
+	GameNumber int                `json:"game_number"`
+	// Stakes still held for the game. Empty once they have been settled, for example when the match closed cleanly.
+	Escrow   map[string]int64 `json:"escrow"`
+	SaveTime int64            `json:"save_time"`
+}
+
+func saveGameSnapshot(ctx context.Context, nk runtime.NakamaModule, matchID string, s *MatchState) error {
+	snapshot := &gameSnapshot{
+		MatchID:   matchID,
+		Label:     s.label,
+		Board:     s.board,
+		Marks:     s.marks,
+		Usernames: make(map[string]string, len(s.marks)),
+		Mark:      s.mark,
+		Moves:     s.moves,
+
+		Rotation:               s.rotation,
+		Placements:             s.placements,
+		DeadlineRemainingTicks: s.deadlineRemainingTicks,
+		Clocks:                 s.clocksAt(time.Now().UTC()),
+		GameNumber:             s.gameNumber,
+		Escrow:                 s.escrow,
+		SaveTime:               time.Now().Unix(),
+	}
+	for userID := range s.marks {
+		if presence := s.presences[userID]; presence != nil {
+			snapshot.Usernames[userID] = presence.GetUsername()
+		}
+	}
+	return writeGameSnapshot(ctx, nk, snapshot)
+}
+
+func writeGameSnapshot(ctx context.Context, nk runtime.NakamaModule, snapshot *gameSnapshot) error {
+	value, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = nk.StorageWrite(ctx, []*runtime.StorageWrite{
+		{
+			Collection:      gameSnapshotCollection,
+			Key:             snapshot.MatchID,
+			Value:           string(value),
+			PermissionRead:  0, // Only server can read
+			PermissionWrite: 0, // Only server can write
+		},
+	})
+	return err
+}
+
+func readGameSnapshot(ctx context.Context, nk runtime.NakamaModule, matchID string) (*gameSnapshot, string, error) {
+	objects, err := nk.StorageRead(ctx, []*runtime.StorageRead{
+		{
+			Collection: gameSnapshotCollection,
+			Key:        matchID,
+		},
+	})
+	if err != nil || len(objects) == 0 {
+		return nil, "", err
+	}
+
+	snapshot := &gameSnapshot{}
+	if err := json.Unmarshal([]byte(objects[0].Value), snapshot); err != nil {
+		return nil, "", err
+	}
+	return snapshot, objects[0].Version, nil
+}
+
+// Discard a saved game no one resumed in time, refunding any stakes it still holds. Reports whether it was discarded.
+func expireGameSnapshot(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, snapshot *gameSnapshot, version string, t time.Time) bool {
+	if t.Before(time.Unix(snapshot.SaveTime, 0).Add(gameSnapshotTTL)) {
+		return false
+	}
+
+	// Only the caller that removes the snapshot refunds its stakes.
+	if err := nk.StorageDelete(ctx, []*runtime.StorageDelete{
+		{
+			Collection: gameSnapshotCollection,
+			Key:        snapshot.MatchID,
+			Version:    version,
+		},
+	}); err != nil {
+		logger.Warn("game snapshot %v already removed: %v", snapshot.MatchID, err)
+		return false
+	}
+	walletSettleStakes(ctx, nk, logger, snapshot.MatchID, snapshot.Escrow, nil)
+	return true
+}
+
+func deleteGameSnapshot(ctx context.Context, nk runtime.NakamaModule, matchID string) error {
+	return nk.StorageDelete(ctx, []*runtime.StorageDelete{
+		{
+			Collection: gameSnapshotCollection,
+			Key:        matchID,
+		},
+	})
+}
+
+// Abandon the game in progress, if any, because the server is shutting down. The game is saved so it can be resumed
+// later, and otherwise counts as a no-contest. Either way stakes are refunded and leaderboards are left untouched.
+func (m *MatchHandler) abandonGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, s *MatchState, graceSeconds int) {
+	matchID := matchIdFromContext(ctx)
+
+	// Refund stakes first, so a saved game does not still hold them.
+	walletSettleStakes(ctx, nk, logger, matchID, s.escrow, nil)
+
+	var resumable bool
+	if s.playing {
+		if err := saveGameSnapshot(ctx, nk, matchID, s); err != nil {
+			logger.Error("error saving game snapshot: %v", err)
+		} else {
+			resumable = true
+		}
+
+		s.playing = false
+		s.deadlineRemainingTicks = 0
+		nk.MetricsCounterAdd(metricGameResults, labelTagsWith(s.label, "result", gameResultNoContest), 1)
+		logMatchEvent(logger, logEventGameEnded, map[string]interface{}{"result": gameResultNoContest, "moves": len(s.moves), "resumable": resumable})
+		text := "Game abandoned, server shutting down"
+		if resumable {
+			text = "Game saved, server shutting down"
+		}
+		postMatchEvent(ctx, logger, nk, s, matchEventGameAbandoned, text)
+	} else if s.resume != nil {
+		// The saved game never got going here, keep it for its players to resume on another server.
+		abandonResume(ctx, logger, nk, s)
+		resumable = true
+	}
+
+	buf, err := m.marshaler.Marshal(&api.Shutdown{
+		GraceSeconds: int32(graceSeconds),
+		Deadline:     time.Now().Add(time.Duration(graceSeconds) * time.Second).Unix(),
+		Resumable:    resumable,
+	})
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+		return
+	}
+	_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_SHUTDOWN), buf, nil, nil, true)
+}
+
+// Give up on resuming a saved game, putting the snapshot back so its players can try again until it expires. Its
+// stakes were refunded when the resume began, so none are carried over.
+func abandonResume(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState) {
+	s.resume.Escrow = nil
+	if err := writeGameSnapshot(ctx, nk, s.resume); err != nil {
+		logger.Error("error restoring game snapshot: %v", err)
+	}
+	s.resume = nil
+}
+
+// Carry on with the game saved in a snapshot, once every player from the original match has joined. Stakes are
+// collected again as for any other game.
+func resumeGame(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState, t time.Time) {
+	snapshot := s.resume
+	s.resume = nil
+
+	s.gameNumber = snapshot.GameNumber
+	s.playing = true
+	s.board = snapshot.Board
+	s.marks = snapshot.Marks
+	s.mark = snapshot.Mark
+	s.moves = snapshot.Moves
+	s.winner = api.Mark_MARK_UNSPECIFIED
+	s.winnerPositions = nil
+	s.rotation = snapshot.Rotation
+	if s.rotation == nil {
+		s.rotation = rotationFromMarks(s.marks)
+	}
+	s.placements = snapshot.Placements
+	if s.placements == nil {
+		s.placements = make(map[api.Mark]int32, len(s.sides()))
+	}
+	s.forfeit = false
+	s.deadlineRemainingTicks = snapshot.DeadlineRemainingTicks
+	if s.deadlineRemainingTicks <= 0 {
+		s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
+	}
+	s.clocks = snapshot.Clocks
+	if s.clocks == nil {
+		s.clocks = newClocks(s.label, s.sides())
+	}
+	s.turnStartedAt = t
+	s.gameStartedAt = t
+
+	logger = logger.WithField("game", s.gameNumber)
+	logMatchEvent(logger, logEventGameResumed, map[string]interface{}{"previous_match_id": snapshot.MatchID, "moves": len(s.moves)})
+	postMatchEvent(ctx, logger, nk, s, matchEventGameResumed, "Game resumed")
+}
+
+// Recreate a match lost to a server restart or node failure from its last checkpoint, and ask both players to join it.
+func rpcResumeMatch(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcResumeMatchRequest{}
+		if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+			return "", errUnmarshal
+		}
+		if request.MatchId == "" {
+			return "", errInvalidInput
+		}
+
+		snapshot, version, err := readGameSnapshot(ctx, nk, request.MatchId)
+		if err != nil {
+			logger.Error("error reading game snapshot: %v", err)
+			return "", errInternalError
+		}
+		if snapshot == nil {
+			return "", errMatchNotFound
+		}
+		if _, ok := snapshot.Marks[userID]; !ok {
+			return "", errMatchNotFound
+		}
+		if expireGameSnapshot(ctx, logger, nk, snapshot, version, time.Now()) {
+			return "", errMatchNotFound
+		}
+
+		// A game can only be resumed once its own match is gone.
+		if match, _ := nk.MatchGet(ctx, request.MatchId); match != nil {
+			return "", errMatchRunning
+		}
+
+		// Claim the snapshot before creating the match, so only one of several concurrent calls goes on to resume it.
+		if err := nk.StorageDelete(ctx, []*runtime.StorageDelete{
+			{
+				Collection: gameSnapshotCollection,
+				Key:        request.MatchId,
+				Version:    version,
+			},
+		}); err != nil {
+			logger.Warn("game snapshot already resumed: %v", err)
+			return "", errMatchNotFound
+		}
+
+		matchID, err := nk.MatchCreate(ctx, moduleName, map[string]interface{}{
+			"fast":      snapshot.Label.Fast,
+			"stake":     snapshot.Label.Stake,
+			"mode":      snapshot.Label.Mode,
+			"variant":   snapshot.Label.Variant,
+			"players":   snapshot.Label.playerCount(),
+			"win_rule":  snapshot.Label.WinRule,
+			"team_size": snapshot.Label.teamSize(),
+
+			"time_control": snapshot.Label.TimeControl,
+			"resume":       snapshot,
+		})
+		if err != nil {
+			logger.Error("error creating match: %v", err)
+			// Put the snapshot back so the game can still be resumed.
+			if err := writeGameSnapshot(ctx, nk, snapshot); err != nil {
+				logger.Error("error restoring game snapshot: %v", err)
+			}
+			return "", errInternalError
+		}
+
+		// The original match did not get to settle its stakes, the resumed game collects them afresh.
+		walletSettleStakes(ctx, nk, logger, request.MatchId, snapshot.Escrow, nil)
+
+		notifications := make([]*runtime.NotificationSend, 0, len(snapshot.Marks))
+		for playerID := range snapshot.Marks {
+			notifications = append(notifications, &runtime.NotificationSend{
+				UserID:  playerID,
+				Subject: "Your game has been resumed",
+				Content: map[string]interface{}{
+					"match_id":          matchID,
+					"previous_match_id": request.MatchId,
+				},
+				Code:       notificationCodeMatchResumed,
+				Persistent: true,
+			})
+		}
+		if err := nk.NotificationsSend(ctx, notifications); err != nil {
+			logger.Error("error sending match resumed notifications: %v", err)
+		}
+
+		buf, err := marshaler.Marshal(&api.RpcResumeMatchResponse{MatchId: matchID})
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
+
+// Discard saved games that were never resumed in time. Meant to be called on a schedule, since games saved when an
+// idle match closes are otherwise only looked at again if a player asks to resume them.
+func rpcAdminExpireGameSnapshots(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		if err := checkAdminCaller(ctx); err != nil {
+			return "", err
+		}
+
+		t := time.Now()
+		response := &api.RpcExpireGameSnapshotsResponse{}
+		cursor := ""
+		for {
+			objects, next, err := nk.StorageList(ctx, "", "", gameSnapshotCollection, gameSnapshotExpireBatchSize, cursor)
+			if err != nil {
+				logger.Error("error listing game snapshots: %v", err)
+				return "", errInternalError
+			}
+			for _, object := range objects {
+				snapshot := &gameSnapshot{}
+				if err := json.Unmarshal([]byte(object.Value), snapshot); err != nil {
+					logger.Warn("error decoding game snapshot %v: %v", object.Key, err)
+					continue
+				}
+				if expireGameSnapshot(ctx, logger, nk, snapshot, object.Version, t) {
+					response.Expired++
+				}
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// Read the match criteria a matchmaker ticket was submitted with. They are the find match request fields, as numeric
+// properties, with booleans given as 0 or 1 and game mode as "mode".
+func matchmakerRequest(properties map[string]interface{}) *api.RpcFindMatchRequest {
+	number := func(key string) int64 {
+		value, _ := properties[key].(float64)
+		return int64(value)
+	}
+	return &api.RpcFindMatchRequest{
+		Fast:           number("fast") == 1,
+		Stake:          number("stake"),
+		GameMode:       api.GameMode(number("mode")),
+		Variant:        api.Variant(number("variant")),
+		Players:        int32(number("players")),
+		WinRule:        api.WinRule(number("win_rule")),
+		Teams:          number("teams") == 1,
+		PartyPlacement: api.PartyPlacement(number("party_placement")),
+		TimeControl:    api.TimeControl(number("time_control")),
+	}
+}
+
+// Create a match for players the matchmaker has grouped together, holding their seats and keeping each party together
+// or apart as it asked. Tickets are expected to only match others with the same criteria, so the first entry's are used.
+func matchmakerMatched(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, entries []runtime.MatchmakerEntry) (string, error) {
+	if len(entries) == 0 {
+		return "", errInvalidInput
+	}
+	request := matchmakerRequest(entries[0].GetProperties())
+	label, err := matchCriteria(request)
+	if err != nil {
+		logger.Warn("invalid matchmaker ticket properties: %v", entries[0].GetProperties())
+		return "", err
+	}
+	if len(entries) > label.playerCount() {
+		logger.Warn("matchmaker matched %v players for a %v player match", len(entries), label.playerCount())
+		return "", errInvalidInput
+	}
+
+	reserved := make(map[string]string, len(entries))
+	parties := make(map[string][]string)
+	for _, entry := range entries {
+		userID := entry.GetPresence().GetUserId()
+		reserved[userID] = entry.GetPartyId()
+		if entry.GetPartyId() != "" {
+			parties[entry.GetPartyId()] = append(parties[entry.GetPartyId()], userID)
+		}
+	}
+
+	partyIDs := make([]string, 0, len(parties))
+	for partyID := range parties {
+		partyIDs = append(partyIDs, partyID)
+	}
+	sort.Strings(partyIDs)
+	teams := make(map[string]int, len(entries))
+	for i, partyID := range partyIDs {
+		members := parties[partyID]
+		sort.Strings(members)
+		memberTeams, err := partyTeams(members, request.PartyPlacement, label, i)
+		if err != nil {
+			logger.Warn("party %v does not fit the match it was matched into", partyID)
+			return "", err
+		}
+		for userID, team := range memberTeams {
+			teams[userID] = team
+		}
+	}
+
+	params := matchParams(label)
+	params["teams"] = teams
+	params["reserved"] = reserved
+	matchID, err := nk.MatchCreate(ctx, moduleName, params)
+	if err != nil {
+		logger.Error("error creating matchmaker match: %v", err)
+		return "", errInternalError
+	}
+	return matchID, nil
+}
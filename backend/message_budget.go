@@ -0,0 +1,141 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+const (
+	// Each player may send at most this many messages in a single tick, and in any one second.
+	messageBudgetPerTick   = 3
+	messageBudgetPerSecond = 8
+
+	// A player collects a strike for each tick they exceed their budget, and for each malformed message. Strikes
+	// expire one at a time after a quiet period, and a player reaching the limit is kicked from the match.
+	messageStrikeLimit    = 5
+	messageStrikeDecaySec = 10
+)
+
+// Message accounting for one player in a match.
+type messageBudget struct {
+	// Messages received from the player in this match, used to identify a message in a rejection.
+	sequence int64
+	// Ticks on which each message in the last second arrived.
+	recentTicks []int64
+	strikes     int
+	// Tick of the most recent strike, or of the most recent expiry since then.
+	strikeTick int64
+	// Tick a rate limit rejection was last sent, so at most one is sent per tick.
+	limitedTick int64
+	kicked      bool
+}
+
+func (s *MatchState) messageBudget(userID string) *messageBudget {
+	budget, ok := s.budgets[userID]
+	if !ok {
+		budget = &messageBudget{limitedTick: -1}
+		s.budgets[userID] = budget
+	}
+	return budget
+}
+
+// Count a message against its sender's budget, reporting whether it should be processed. Messages over budget are
+// dropped, with a single rejection per tick so a flood is never answered in kind.
+func (m *MatchHandler) admitMessage(logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, message runtime.MatchData) bool {
+	budget := s.messageBudget(message.GetUserId())
+	budget.sequence++
+	if budget.kicked {
+		return false
+	}
+
+	if budget.strikes > 0 && tick-budget.strikeTick >= messageStrikeDecaySec*tickRate {
+		budget.strikes--
+		budget.strikeTick = tick
+	}
+
+	windowStart := tick - tickRate
+	recent := budget.recentTicks[:0]
+	var thisTick int
+	for _, received := range budget.recentTicks {
+		if received > windowStart {
+			recent = append(recent, received)
+			if received == tick {
+				thisTick++
+			}
+		}
+	}
+	budget.recentTicks = recent
+
+	if thisTick < messageBudgetPerTick && len(recent) < messageBudgetPerSecond {
+		budget.recentTicks = append(budget.recentTicks, tick)
+		return true
+	}
+
+	if budget.limitedTick != tick {
+		budget.limitedTick = tick
+		logger.Debug("message budget exceeded by %v", message.GetUserId())
+		m.reject(logger, dispatcher, s, message, api.RejectReason_REJECT_REASON_RATE_LIMITED)
+		m.strike(logger, dispatcher, tick, s, message)
+	}
+	return false
+}
+
+// Record a strike against a player, kicking them from the match once they reach the limit.
+func (m *MatchHandler) strike(logger runtime.Logger, dispatcher runtime.MatchDispatcher, tick int64, s *MatchState, message runtime.MatchData) {
+	budget := s.messageBudget(message.GetUserId())
+	budget.strikes++
+	budget.strikeTick = tick
+	if budget.strikes < messageStrikeLimit || budget.kicked {
+		return
+	}
+
+	presence := s.presences[message.GetUserId()]
+	if presence == nil {
+		return
+	}
+	budget.kicked = true
+	logger.Warn("kicking %v for message abuse", message.GetUserId())
+	if err := dispatcher.MatchKick([]runtime.Presence{presence}); err != nil {
+		logger.Error("error kicking player: %v", err)
+	}
+}
+
+// Tell a player why their message was rejected.
+func (m *MatchHandler) reject(logger runtime.Logger, dispatcher runtime.MatchDispatcher, s *MatchState, message runtime.MatchData, reason api.RejectReason) {
+	m.rejectSequence(logger, dispatcher, message, s.messageBudget(message.GetUserId()).sequence, reason)
+}
+
+// Tell a player why their move was rejected, identifying it by the client's own sequence number if it has one.
+func (m *MatchHandler) rejectMove(logger runtime.Logger, dispatcher runtime.MatchDispatcher, s *MatchState, message runtime.MatchData, move *api.Move, reason api.RejectReason) {
+	if move.Sequence == 0 {
+		m.reject(logger, dispatcher, s, message, reason)
+		return
+	}
+	m.rejectSequence(logger, dispatcher, message, move.Sequence, reason)
+}
+
+func (m *MatchHandler) rejectSequence(logger runtime.Logger, dispatcher runtime.MatchDispatcher, message runtime.MatchData, sequence int64, reason api.RejectReason) {
+	buf, err := m.marshaler.Marshal(&api.Rejected{
+		Reason:   reason,
+		Sequence: sequence,
+	})
+	if err != nil {
+		logger.Error("error encoding message: %v", err)
+		return
+	}
+	_ = dispatcher.BroadcastMessage(int64(api.OpCode_OPCODE_REJECTED), buf, []runtime.Presence{message}, nil, true)
+}
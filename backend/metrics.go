@@ -0,0 +1,132 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// Metric names reported through the Nakama metrics API.
+const (
+	metricMatchesCreated      = "xoxo_matches_created"
+	metricMatchesClosed       = "xoxo_matches_closed"
+	metricGamesStarted        = "xoxo_games_started"
+	metricGameResults         = "xoxo_game_results"
+	metricMoveLatency         = "xoxo_move_latency"
+	metricFindMatchLatency    = "xoxo_find_match_latency"
+	metricFindMatchDuplicates = "xoxo_find_match_duplicates"
+	metricOpenMatches         = "xoxo_open_matches"
+)
+
+// Reasons a match closes, reported as the "reason" tag.
+const (
+	matchCloseIdle       = "idle"
+	matchCloseGameOver   = "game_over"
+	matchCloseTerminated = "terminated"
+	matchCloseForceEnded = "force_ended"
+	// Not every player came back to resume a saved game in time.
+	matchCloseResumeExpired = "resume_expired"
+)
+
+// Game results, reported as the "result" tag.
+const (
+	gameResultXWin        = "x_win"
+	gameResultOWin        = "o_win"
+	gameResultTriangleWin = "triangle_win"
+	gameResultSquareWin   = "square_win"
+	gameResultDraw        = "draw"
+	gameResultForfeit     = "forfeit"
+	// The game was abandoned by a server shutdown.
+	gameResultNoContest = "no_contest"
+)
+
+// Tags describing the kind of match a metric relates to. Kept to a small fixed set of values so dashboards can
+// group by them cheaply.
+func labelTags(label *MatchLabel) map[string]string {
+	tags := map[string]string{
+		"speed":  label.speed(),
+		"staked": "false",
+	}
+	if label.Stake > 0 {
+		tags["staked"] = "true"
+	}
+	tags["game_mode"] = gameModeName(api.GameMode(label.Mode))
+	tags["variant"] = variantName(api.Variant(label.Variant))
+	tags["players"] = strconv.Itoa(label.playerCount())
+	tags["teams"] = strconv.FormatBool(label.teamSize() > 1)
+	tags["time_control"] = timeControlName(api.TimeControl(label.TimeControl))
+	return tags
+}
+
+func labelTagsWith(label *MatchLabel, key, value string) map[string]string {
+	tags := labelTags(label)
+	tags[key] = value
+	return tags
+}
+
+func gameResult(s *MatchState) string {
+	switch {
+	case s.forfeit:
+		return gameResultForfeit
+	case s.winner == api.Mark_MARK_X:
+		return gameResultXWin
+	case s.winner == api.Mark_MARK_O:
+		return gameResultOWin
+	case s.winner == api.Mark_MARK_TRIANGLE:
+		return gameResultTriangleWin
+	case s.winner == api.Mark_MARK_SQUARE:
+		return gameResultSquareWin
+	default:
+		return gameResultDraw
+	}
+}
+
+func recordMatchClosed(nk runtime.NakamaModule, s *MatchState, reason string) {
+	nk.MetricsCounterAdd(metricMatchesClosed, labelTagsWith(s.label, "reason", reason), 1)
+	if s.label.Open == 1 {
+		s.label.Open = 0
+		addOpenMatches(nk, s.label, -1)
+	}
+}
+
+// Number of matches on this node advertising themselves as open, by their tags. The gauge is updated whenever a match
+// label opens or closes, rather than sampled when someone searches for a match.
+var openMatches = struct {
+	sync.Mutex
+	counts map[string]float64
+}{counts: make(map[string]float64)}
+
+func addOpenMatches(nk runtime.NakamaModule, label *MatchLabel, delta float64) {
+	tags := labelTags(label)
+	keys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		keys = append(keys, key+"="+value)
+	}
+	slices.Sort(keys)
+	key := strings.Join(keys, ",")
+
+	openMatches.Lock()
+	openMatches.counts[key] += delta
+	count := openMatches.counts[key]
+	openMatches.Unlock()
+
+	nk.MetricsGaugeSet(metricOpenMatches, tags, count)
+}
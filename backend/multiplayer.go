@@ -0,0 +1,284 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"time"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+const (
+	minPlayers = 2
+	maxPlayers = 4
+
+	// Lines on the larger multi-player boards need four marks in a row.
+	multiplayerLineLength = 4
+)
+
+// Marks in turn order. A game with n sides uses the first n.
+var turnOrder = []api.Mark{api.Mark_MARK_X, api.Mark_MARK_O, api.Mark_MARK_TRIANGLE, api.Mark_MARK_SQUARE}
+
+// Winning lines for each multi-player board size.
+var gridLines = map[int32][][]int32{
+	5: generateGridLines(5, multiplayerLineLength),
+	6: generateGridLines(6, multiplayerLineLength),
+}
+
+// The number of players the match is for. Labels from before multi-player matches are for two.
+func (l *MatchLabel) playerCount() int {
+	if l.Players < minPlayers {
+		return minPlayers
+	}
+	return l.Players
+}
+
+// The number of marks in play, one for each player or team.
+func (l *MatchLabel) markCount() int {
+	return l.playerCount() / l.teamSize()
+}
+
+// Games with more than two sides are only played with the classic rules.
+func playersAllowed(mode api.GameMode, variant api.Variant, sides int) bool {
+	if sides < minPlayers || sides > maxPlayers {
+		return false
+	}
+	return sides == minPlayers || (mode == api.GameMode_GAME_MODE_CLASSIC && variant == api.Variant_VARIANT_STANDARD)
+}
+
+// A square board with room for more players, won by a line of the given length in any direction.
+type gridRules struct {
+	size   int32
+	length int32
+}
+
+func rulesForPlayers(sides int) gridRules {
+	return gridRules{size: int32(sides) + 2, length: multiplayerLineLength}
+}
+
+func (r gridRules) shape() *api.BoardShape {
+	return &api.BoardShape{Layers: 1, Rows: r.size, Columns: r.size}
+}
+
+func (gridRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != move.Mark {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	return checkPosition(board, move.Position)
+}
+
+func (r gridRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	if line := completedLine(board, move.Mark, gridLines[r.size]); line != nil {
+		return true, move.Mark, line
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// Every line of the given length across a square board: rows, columns and both diagonals.
+func generateGridLines(size, length int32) [][]int32 {
+	var lines [][]int32
+	for _, direction := range [][2]int32{{0, 1}, {1, 0}, {1, 1}, {1, -1}} {
+		for r := int32(0); r < size; r++ {
+			for c := int32(0); c < size; c++ {
+				endR, endC := r+(length-1)*direction[0], c+(length-1)*direction[1]
+				if endR < 0 || endR >= size || endC < 0 || endC >= size {
+					continue
+				}
+				line := make([]int32, 0, length)
+				for i := int32(0); i < length; i++ {
+					line = append(line, (r+i*direction[0])*size+c+i*direction[1])
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+// True if sides who complete a line drop out and the rest play on.
+func (s *MatchState) eliminating() bool {
+	return s.label.markCount() > minPlayers && api.WinRule(s.label.WinRule) == api.WinRule_WIN_RULE_ELIMINATION
+}
+
+// The marks in play, one for each player or team.
+func (s *MatchState) sides() []api.Mark {
+	return turnOrder[:s.label.markCount()]
+}
+
+// The sides that have not been placed yet.
+func (s *MatchState) unplaced() []api.Mark {
+	marks := make([]api.Mark, 0, len(s.sides()))
+	for _, mark := range s.sides() {
+		if _, placed := s.placements[mark]; !placed {
+			marks = append(marks, mark)
+		}
+	}
+	return marks
+}
+
+// The best place no one has taken yet. Places fill from the top as sides complete lines.
+func (s *MatchState) bestOpenPlace() int32 {
+	place := int32(1)
+	for place <= int32(len(s.sides())) && s.placeTaken(place) {
+		place++
+	}
+	return place
+}
+
+// The worst place no one has taken yet. Places fill from the bottom as sides run out of time.
+func (s *MatchState) worstOpenPlace() int32 {
+	place := int32(len(s.sides()))
+	for place > 1 && s.placeTaken(place) {
+		place--
+	}
+	return place
+}
+
+func (s *MatchState) placeTaken(place int32) bool {
+	for _, taken := range s.placements {
+		if taken == place {
+			return true
+		}
+	}
+	return false
+}
+
+// The next mark to play after the current one, skipping sides that have already been placed.
+func (s *MatchState) nextMark() api.Mark {
+	order := s.sides()
+	current := 0
+	for i, mark := range order {
+		if mark == s.mark {
+			current = i
+		}
+	}
+	for i := 1; i <= len(order); i++ {
+		mark := order[(current+i)%len(order)]
+		if _, placed := s.placements[mark]; !placed {
+			return mark
+		}
+	}
+	return s.mark
+}
+
+// A side completed a line in an elimination game and takes the best place left. Reports whether the game is over,
+// because at most one side is left to play or the board is full.
+func (s *MatchState) finishSide(mark api.Mark, line []int32) bool {
+	place := s.bestOpenPlace()
+	s.placements[mark] = place
+	if place == 1 {
+		s.winnerPositions = line
+	}
+	return len(s.unplaced()) <= 1 || isBoardFull(s.board)
+}
+
+// The side to move ran out of time and drops out with the worst place left. Reports whether the game is over
+// because only one side is left, otherwise play passes to the next side.
+func (s *MatchState) timeOut(t time.Time) bool {
+	s.placements[s.mark] = s.worstOpenPlace()
+	if s.clocks != nil {
+		s.clocks[s.mark] = 0
+	}
+	if len(s.unplaced()) <= 1 {
+		return true
+	}
+	s.mark = s.nextMark()
+	s.deadlineRemainingTicks = calculateDeadlineTicks(s.label)
+	s.turnStartedAt = t
+	return false
+}
+
+// Place every side still playing when the game ends. The winner, if they have not been placed already, takes the best
+// place left, and the rest share the next one. The winner is then whoever alone holds first place, if anyone does.
+func settlePlacements(s *MatchState) {
+	if s.placements == nil {
+		s.placements = make(map[api.Mark]int32, len(s.sides()))
+	}
+	if s.winner != api.Mark_MARK_UNSPECIFIED {
+		if _, placed := s.placements[s.winner]; !placed {
+			s.placements[s.winner] = s.bestOpenPlace()
+		}
+	}
+	place := s.bestOpenPlace()
+	for _, mark := range s.unplaced() {
+		s.placements[mark] = place
+	}
+
+	s.winner = api.Mark_MARK_UNSPECIFIED
+	for mark := range s.placements {
+		if place, tied := s.markPlacement(mark); place == 1 && tied == 1 {
+			s.winner = mark
+		}
+	}
+}
+
+// A side's place and the number of sides sharing it.
+func (s *MatchState) markPlacement(mark api.Mark) (int32, int) {
+	place := s.placements[mark]
+	var tied int
+	for _, other := range s.placements {
+		if other == place {
+			tied++
+		}
+	}
+	return place, tied
+}
+
+// A player's place, shared with their teammates, and the number of sides sharing it.
+func (s *MatchState) placement(userID string) (int32, int) {
+	return s.markPlacement(s.marks[userID])
+}
+
+// Places decided so far, keyed by user ID.
+func (s *MatchState) userPlacements() map[string]int32 {
+	placements := make(map[string]int32, len(s.marks))
+	for userID, mark := range s.marks {
+		if place, ok := s.placements[mark]; ok {
+			placements[userID] = place
+		}
+	}
+	return placements
+}
+
+// The leaderboard score for a player's place. Places are spread evenly from a win down to a loss, tied sides average
+// the places they share, and a game where everyone ties scores as a draw. Teammates score the same.
+func placementScore(s *MatchState, userID string) int64 {
+	place, tied := s.placement(userID)
+	sides := int64(len(s.sides()))
+	if tied == len(s.sides()) || sides < minPlayers {
+		return scoreDraw
+	}
+	var total int64
+	for p := int64(place); p < int64(place)+int64(tied); p++ {
+		total += scoreWin - (p-1)*(scoreWin-scoreLoss)/(sides-1)
+	}
+	return total / int64(tied)
+}
+
+// The name of a mark for display.
+func markName(mark api.Mark) string {
+	switch mark {
+	case api.Mark_MARK_X:
+		return "X"
+	case api.Mark_MARK_O:
+		return "O"
+	case api.Mark_MARK_TRIANGLE:
+		return "Triangle"
+	case api.Mark_MARK_SQUARE:
+		return "Square"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// A multi-player game just started, with one player for each side named after its mark, or two for team games.
+func testMultiplayerState(players, teamSize int, winRule api.WinRule) *MatchState {
+	label := &MatchLabel{Players: players, TeamSize: teamSize, WinRule: int(winRule)}
+	s := &MatchState{
+		label:      label,
+		board:      newBoard(rulesForPlayers(label.markCount()).shape()),
+		marks:      make(map[string]api.Mark, players),
+		placements: make(map[api.Mark]int32),
+		mark:       api.Mark_MARK_X,
+	}
+	for i := 0; i < players; i++ {
+		mark := turnOrder[i%label.markCount()]
+		s.marks[markName(mark)+string(rune('1'+i/label.markCount()))] = mark
+	}
+	return s
+}
+
+// How a side leaves an elimination game: by completing a line, or by running out of time.
+type testExit struct {
+	mark api.Mark
+	line bool
+}
+
+func TestEliminationPlacements(t *testing.T) {
+	x, o, tri, sq := api.Mark_MARK_X, api.Mark_MARK_O, api.Mark_MARK_TRIANGLE, api.Mark_MARK_SQUARE
+	tests := []struct {
+		name    string
+		players int
+		exits   []testExit
+		// Whether the game is over after each exit.
+		over   []bool
+		want   map[api.Mark]int32
+		winner api.Mark
+	}{
+		{
+			name:    "lines fill places from the top",
+			players: 4,
+			exits:   []testExit{{o, true}, {x, true}, {sq, true}},
+			over:    []bool{false, false, true},
+			want:    map[api.Mark]int32{o: 1, x: 2, sq: 3, tri: 4},
+			winner:  o,
+		},
+		{
+			name:    "timeouts fill places from the bottom",
+			players: 4,
+			exits:   []testExit{{sq, false}, {x, false}, {tri, false}},
+			over:    []bool{false, false, true},
+			want:    map[api.Mark]int32{sq: 4, x: 3, tri: 2, o: 1},
+			winner:  o,
+		},
+		{
+			name:    "lines and timeouts meet in the middle",
+			players: 4,
+			exits:   []testExit{{tri, true}, {x, false}, {o, true}},
+			over:    []bool{false, false, true},
+			want:    map[api.Mark]int32{tri: 1, x: 4, o: 2, sq: 3},
+			winner:  tri,
+		},
+		{
+			name:    "three sides",
+			players: 3,
+			exits:   []testExit{{x, true}, {tri, true}},
+			over:    []bool{false, true},
+			want:    map[api.Mark]int32{x: 1, tri: 2, o: 3},
+			winner:  x,
+		},
+		{
+			name:    "sides left share a place when the game ends",
+			players: 4,
+			exits:   []testExit{{x, true}},
+			over:    []bool{false},
+			want:    map[api.Mark]int32{x: 1, o: 2, tri: 2, sq: 2},
+			winner:  x,
+		},
+		{
+			name:    "everyone shares first when no one is placed",
+			players: 4,
+			want:    map[api.Mark]int32{x: 1, o: 1, tri: 1, sq: 1},
+			winner:  api.Mark_MARK_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testMultiplayerState(tt.players, 1, api.WinRule_WIN_RULE_ELIMINATION)
+			if !s.eliminating() {
+				t.Fatal("eliminating() = false, want true")
+			}
+			for i, exit := range tt.exits {
+				var over bool
+				if exit.line {
+					over = s.finishSide(exit.mark, []int32{int32(i)})
+				} else {
+					s.mark = exit.mark
+					over = s.timeOut(time.Time{})
+				}
+				if over != tt.over[i] {
+					t.Errorf("exit %v: over = %v, want %v", i, over, tt.over[i])
+				}
+			}
+			settlePlacements(s)
+			if !maps.Equal(s.placements, tt.want) {
+				t.Errorf("placements = %v, want %v", s.placements, tt.want)
+			}
+			if s.winner != tt.winner {
+				t.Errorf("winner = %v, want %v", s.winner, tt.winner)
+			}
+		})
+	}
+}
+
+func TestEliminationNextMark(t *testing.T) {
+	s := testMultiplayerState(4, 1, api.WinRule_WIN_RULE_ELIMINATION)
+	s.placements[api.Mark_MARK_O] = 1
+	s.placements[api.Mark_MARK_TRIANGLE] = 4
+	if got := s.nextMark(); got != api.Mark_MARK_SQUARE {
+		t.Errorf("nextMark() after X = %v, want %v", got, api.Mark_MARK_SQUARE)
+	}
+	s.mark = api.Mark_MARK_SQUARE
+	if got := s.nextMark(); got != api.Mark_MARK_X {
+		t.Errorf("nextMark() after Square = %v, want %v", got, api.Mark_MARK_X)
+	}
+}
+
+func TestFirstLineEndsGame(t *testing.T) {
+	s := testMultiplayerState(4, 1, api.WinRule_WIN_RULE_FIRST_LINE)
+	if s.eliminating() {
+		t.Fatal("eliminating() = true, want false")
+	}
+	rules := rulesForPlayers(4)
+	for i, position := range []int32{0, 1, 2} {
+		if s.playMove(rules, &moveRecord{Mark: api.Mark_MARK_X, Piece: api.Mark_MARK_X, Position: position}) {
+			t.Fatalf("move %v ended the game", i)
+		}
+		s.mark = api.Mark_MARK_X
+	}
+	if !s.playMove(rules, &moveRecord{Mark: api.Mark_MARK_X, Piece: api.Mark_MARK_X, Position: 3}) {
+		t.Fatal("four in a row did not end the game")
+	}
+	settlePlacements(s)
+	want := map[api.Mark]int32{api.Mark_MARK_X: 1, api.Mark_MARK_O: 2, api.Mark_MARK_TRIANGLE: 2, api.Mark_MARK_SQUARE: 2}
+	if !maps.Equal(s.placements, want) || s.winner != api.Mark_MARK_X {
+		t.Errorf("placements = %v, winner %v, want %v, winner %v", s.placements, s.winner, want, api.Mark_MARK_X)
+	}
+}
+
+func TestPlacementScores(t *testing.T) {
+	x, o, tri, sq := api.Mark_MARK_X, api.Mark_MARK_O, api.Mark_MARK_TRIANGLE, api.Mark_MARK_SQUARE
+	tests := []struct {
+		name       string
+		players    int
+		teamSize   int
+		placements map[api.Mark]int32
+		scores     map[string]int64
+		outcomes   map[string]playerOutcome
+	}{
+		{
+			name:       "two players",
+			players:    2,
+			placements: map[api.Mark]int32{x: 2, o: 1},
+			scores:     map[string]int64{"X1": scoreLoss, "O1": scoreWin},
+			outcomes:   map[string]playerOutcome{"X1": outcomeLoss, "O1": outcomeWin},
+		},
+		{
+			name:       "two players draw",
+			players:    2,
+			placements: map[api.Mark]int32{x: 1, o: 1},
+			scores:     map[string]int64{"X1": scoreDraw, "O1": scoreDraw},
+			outcomes:   map[string]playerOutcome{"X1": outcomeDraw, "O1": outcomeDraw},
+		},
+		{
+			name:       "four places spread evenly",
+			players:    4,
+			placements: map[api.Mark]int32{x: 3, o: 1, tri: 4, sq: 2},
+			scores:     map[string]int64{"X1": -33, "O1": scoreWin, "Triangle1": scoreLoss, "Square1": 34},
+			outcomes:   map[string]playerOutcome{"X1": outcomeLoss, "O1": outcomeWin, "Triangle1": outcomeLoss, "Square1": outcomeLoss},
+		},
+		{
+			name:       "tied sides average their places",
+			players:    4,
+			placements: map[api.Mark]int32{x: 1, o: 2, tri: 2, sq: 2},
+			scores:     map[string]int64{"X1": scoreWin, "O1": -33, "Triangle1": -33, "Square1": -33},
+			outcomes:   map[string]playerOutcome{"X1": outcomeWin, "O1": outcomeLoss, "Triangle1": outcomeLoss, "Square1": outcomeLoss},
+		},
+		{
+			name:       "shared first place",
+			players:    3,
+			placements: map[api.Mark]int32{x: 1, o: 1, tri: 3},
+			scores:     map[string]int64{"X1": 50, "O1": 50, "Triangle1": scoreLoss},
+			outcomes:   map[string]playerOutcome{"X1": outcomeDraw, "O1": outcomeDraw, "Triangle1": outcomeLoss},
+		},
+		{
+			name:       "everyone tied",
+			players:    4,
+			placements: map[api.Mark]int32{x: 1, o: 1, tri: 1, sq: 1},
+			scores:     map[string]int64{"X1": scoreDraw, "O1": scoreDraw, "Triangle1": scoreDraw, "Square1": scoreDraw},
+			outcomes:   map[string]playerOutcome{"X1": outcomeDraw, "O1": outcomeDraw, "Triangle1": outcomeDraw, "Square1": outcomeDraw},
+		},
+		{
+			name:       "teammates score the same",
+			players:    4,
+			teamSize:   2,
+			placements: map[api.Mark]int32{x: 1, o: 2},
+			scores:     map[string]int64{"X1": scoreWin, "X2": scoreWin, "O1": scoreLoss, "O2": scoreLoss},
+			outcomes:   map[string]playerOutcome{"X1": outcomeWin, "X2": outcomeWin, "O1": outcomeLoss, "O2": outcomeLoss},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testMultiplayerState(tt.players, tt.teamSize, api.WinRule_WIN_RULE_ELIMINATION)
+			s.placements = tt.placements
+			for userID, want := range tt.scores {
+				if got := placementScore(s, userID); got != want {
+					t.Errorf("placementScore(%v) = %v, want %v", userID, got, want)
+				}
+			}
+			if got := gameOutcomes(s); !maps.Equal(got, tt.outcomes) {
+				t.Errorf("gameOutcomes() = %v, want %v", got, tt.outcomes)
+			}
+		})
+	}
+}
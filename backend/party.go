@@ -0,0 +1,170 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+const (
+	streamModeParty = 7
+
+	// How long seats are held for the members of a party before they are given up.
+	partyReserveSec = 20
+)
+
+// List the members of a realtime party. Party IDs are the party's UUID and the node hosting it, joined by a dot.
+func partyMembers(ctx context.Context, nk runtime.NakamaModule, partyID string) ([]string, error) {
+	partyUUID, node, ok := strings.Cut(partyID, ".")
+	if !ok || partyUUID == "" || node == "" {
+		return nil, errInvalidInput
+	}
+	presences, err := nk.StreamUserList(streamModeParty, partyUUID, "", node, true, true)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(presences))
+	for _, presence := range presences {
+		userIDs = append(userIDs, presence.GetUserId())
+	}
+	sort.Strings(userIDs)
+	return slices.Compact(userIDs), nil
+}
+
+// Split a party's members into teams for the given match, counting from the first team index. Teammates all go on the
+// first team, opponents go round the sides in turn. A party that doesn't say plays together in team games, and against
+// each other otherwise.
+func partyTeams(members []string, placement api.PartyPlacement, label *MatchLabel, first int) (map[string]int, error) {
+	if placement == api.PartyPlacement_PARTY_PLACEMENT_UNSPECIFIED {
+		placement = api.PartyPlacement_PARTY_PLACEMENT_OPPONENTS
+		if label.teamSize() > 1 {
+			placement = api.PartyPlacement_PARTY_PLACEMENT_TEAMMATES
+		}
+	}
+
+	sides := label.markCount()
+	teams := make(map[string]int, len(members))
+	switch placement {
+	case api.PartyPlacement_PARTY_PLACEMENT_TEAMMATES:
+		if label.teamSize() < 2 || len(members) > label.teamSize() {
+			return nil, errInvalidInput
+		}
+		for _, userID := range members {
+			teams[userID] = first % sides
+		}
+	case api.PartyPlacement_PARTY_PLACEMENT_OPPONENTS:
+		if len(members) > label.playerCount() {
+			return nil, errInvalidInput
+		}
+		for i, userID := range members {
+			teams[userID] = (first + i) % sides
+		}
+	default:
+		return nil, errInvalidInput
+	}
+	return teams, nil
+}
+
+// Create a match for the caller's party, holding a seat for each member and telling the others where to join.
+func createPartyMatch(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, userID string, request *api.RpcFindMatchRequest, label *MatchLabel) (string, error) {
+	members, err := partyMembers(ctx, nk, request.PartyId)
+	if err == errInvalidInput {
+		return "", err
+	}
+	if err != nil {
+		logger.Error("error listing party members: %v", err)
+		return "", errInternalError
+	}
+	if !slices.Contains(members, userID) {
+		return "", errPermissionDenied
+	}
+	teams, err := partyTeams(members, request.PartyPlacement, label, 0)
+	if err != nil {
+		return "", err
+	}
+
+	reserved := make(map[string]string, len(members))
+	for _, memberID := range members {
+		reserved[memberID] = request.PartyId
+	}
+	params := matchParams(label)
+	params["teams"] = teams
+	params["reserved"] = reserved
+	matchID, err := nk.MatchCreate(ctx, moduleName, params)
+	if err != nil {
+		logger.Error("error creating party match: %v", err)
+		return "", errInternalError
+	}
+
+	notifications := make([]*runtime.NotificationSend, 0, len(members))
+	for _, memberID := range members {
+		if memberID == userID {
+			continue
+		}
+		notifications = append(notifications, &runtime.NotificationSend{
+			UserID:  memberID,
+			Subject: "Your party found a match",
+			Content: map[string]interface{}{
+				"match_id": matchID,
+				"party_id": request.PartyId,
+			},
+			Code:   notificationCodePartyMatchFound,
+			Sender: userID,
+		})
+	}
+	if len(notifications) > 0 {
+		if err := nk.NotificationsSend(ctx, notifications); err != nil {
+			logger.Error("error sending party match notifications: %v", err)
+		}
+	}
+	return matchID, nil
+}
+
+// Tell the rest of a player's party that they dropped out before the match got going.
+func partyMemberDropped(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, s *MatchState, userID string) {
+	partyID, ok := s.parties[userID]
+	if !ok {
+		return
+	}
+	delete(s.parties, userID)
+
+	notifications := make([]*runtime.NotificationSend, 0, len(s.parties))
+	for memberID, memberPartyID := range s.parties {
+		if memberPartyID != partyID {
+			continue
+		}
+		notifications = append(notifications, &runtime.NotificationSend{
+			UserID:  memberID,
+			Subject: "A party member dropped out",
+			Content: map[string]interface{}{
+				"match_id": matchIdFromContext(ctx),
+				"party_id": partyID,
+				"user_id":  userID,
+			},
+			Code: notificationCodePartyMemberDropped,
+		})
+	}
+	if len(notifications) > 0 {
+		if err := nk.NotificationsSend(ctx, notifications); err != nil {
+			logger.Error("error sending party member dropped notifications: %v", err)
+		}
+	}
+}
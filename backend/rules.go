@@ -0,0 +1,150 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// The rules of a game mode: the board it is played on, which moves are legal, and when the game is over.
+type gameRules interface {
+	// The dimensions of the board the game is played on.
+	shape() *api.BoardShape
+	// Why the move may not be played next, given the moves played so far, or unspecified if it may.
+	checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason
+	// Whether the game is over now the move has been played. If it was won, the mark of the winning player and the
+	// positions of the deciding line are returned too, otherwise it is a draw.
+	result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32)
+}
+
+func rulesFor(label *MatchLabel) gameRules {
+	if sides := label.markCount(); sides > minPlayers {
+		return rulesForPlayers(sides)
+	}
+	switch api.GameMode(label.Mode) {
+	case api.GameMode_GAME_MODE_ULTIMATE:
+		return ultimateRules{}
+	case api.GameMode_GAME_MODE_CUBE_3:
+		return cubeRules{size: 3}
+	case api.GameMode_GAME_MODE_CUBE_4:
+		return cubeRules{size: 4}
+	}
+	switch api.Variant(label.Variant) {
+	case api.Variant_VARIANT_MISERE:
+		return misereRules{}
+	case api.Variant_VARIANT_WILD:
+		return wildRules{}
+	case api.Variant_VARIANT_NUMERICAL:
+		return numericalRules{}
+	default:
+		return classicRules{}
+	}
+}
+
+// The name of a game mode in metrics and logs.
+func gameModeName(mode api.GameMode) string {
+	switch mode {
+	case api.GameMode_GAME_MODE_ULTIMATE:
+		return "ultimate"
+	case api.GameMode_GAME_MODE_CUBE_3:
+		return "cube_3"
+	case api.GameMode_GAME_MODE_CUBE_4:
+		return "cube_4"
+	default:
+		return "classic"
+	}
+}
+
+// Variants other than standard change the classic rules, so are only played in classic mode.
+func variantAllowed(mode api.GameMode, variant api.Variant) bool {
+	return variant == api.Variant_VARIANT_STANDARD || mode == api.GameMode_GAME_MODE_CLASSIC
+}
+
+// The name of a rule variant in metrics and logs.
+func variantName(variant api.Variant) string {
+	switch variant {
+	case api.Variant_VARIANT_MISERE:
+		return "misere"
+	case api.Variant_VARIANT_WILD:
+		return "wild"
+	case api.Variant_VARIANT_NUMERICAL:
+		return "numerical"
+	default:
+		return "standard"
+	}
+}
+
+// Classic tic-tac-toe on a single 3x3 board.
+type classicRules struct{}
+
+func (classicRules) shape() *api.BoardShape {
+	return &api.BoardShape{Layers: 1, Rows: 3, Columns: 3}
+}
+
+func (classicRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != move.Mark {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	return checkPosition(board, move.Position)
+}
+
+func (classicRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	if line := winningLine(board, move.Mark); line != nil {
+		return true, move.Mark, line
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// The empty board a game starts on.
+func newBoard(shape *api.BoardShape) []api.Mark {
+	return make([]api.Mark, shape.Layers*shape.Rows*shape.Columns)
+}
+
+// The position of the cell at the coordinates, or -1 if they are outside the board.
+func coordinatesPosition(shape *api.BoardShape, coordinates *api.Coordinates) int32 {
+	if coordinates.Layer < 0 || coordinates.Layer >= shape.Layers ||
+		coordinates.Row < 0 || coordinates.Row >= shape.Rows ||
+		coordinates.Column < 0 || coordinates.Column >= shape.Columns {
+		return -1
+	}
+	return (coordinates.Layer*shape.Rows+coordinates.Row)*shape.Columns + coordinates.Column
+}
+
+// Check the position is on the board and empty.
+func checkPosition(board []api.Mark, position int32) api.RejectReason {
+	if position < 0 || int(position) >= len(board) || board[position] != api.Mark_MARK_UNSPECIFIED {
+		return api.RejectReason_REJECT_REASON_INVALID_POSITION
+	}
+	return api.RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+// The first line of a 3x3 board held entirely by the mark, or nil if there is none.
+func winningLine(board []api.Mark, mark api.Mark) []int32 {
+	return completedLine(board, mark, winningPositions)
+}
+
+// The first of the lines held entirely by the mark, or nil if there is none.
+func completedLine(board []api.Mark, mark api.Mark, lines [][]int32) []int32 {
+winCheck:
+	for _, winningPosition := range lines {
+		for _, position := range winningPosition {
+			if board[position] != mark {
+				continue winCheck
+			}
+		}
+		return winningPosition
+	}
+	return nil
+}
@@ -23,13 +23,26 @@ import (
 )
 
 const (
-	notificationCodeSingleDevice = 101
+	notificationCodeSingleDevice       = 101
+	notificationCodeDailyReward        = 102
+	notificationCodeAchievement        = 103
+	notificationCodeMatchResumed       = 104
+	notificationCodePartyMatchFound    = 105
+	notificationCodePartyMemberDropped = 106
+	notificationCodeAsyncTurn          = 107
+	notificationCodeAsyncGameOver      = 108
+	notificationCodeAsyncInvite        = 109
 
 	streamModeNotification = 0
 )
 
 func registerSessionEvents(db *sql.DB, nk runtime.NakamaModule, initializer runtime.Initializer) error {
-	if err := initializer.RegisterEventSessionStart(eventSessionStartFunc(nk)); err != nil {
+	singleDevice := eventSessionStartFunc(nk)
+	dailyReward := eventSessionStartDailyRewardFunc(nk)
+	if err := initializer.RegisterEventSessionStart(func(ctx context.Context, logger runtime.Logger, evt *api.Event) {
+		singleDevice(ctx, logger, evt)
+		dailyReward(ctx, logger, evt)
+	}); err != nil {
 		return err
 	}
 	if err := initializer.RegisterEventSessionEnd(eventSessionEndFunc(db)); err != nil {
@@ -0,0 +1,145 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// Team games are 2v2, with teammates sharing a mark.
+const playersPerTeam = 2
+
+// The number of players sharing each mark. Labels from before team games have one.
+func (l *MatchLabel) teamSize() int {
+	if l.TeamSize < 1 {
+		return 1
+	}
+	return l.TeamSize
+}
+
+// Assign a mark to everyone in the match and fix the order each mark's players take their turns in. Players put on a
+// team by the matchmaker or their party are kept together, and everyone else fills the spaces left in random order.
+func (s *MatchState) assignMarks() {
+	userIDs := make([]string, 0, len(s.presences))
+	for userID := range s.presences {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+	s.random.Shuffle(len(userIDs), func(i, j int) { userIDs[i], userIDs[j] = userIDs[j], userIDs[i] })
+
+	sides := s.sides()
+	size := s.label.teamSize()
+	s.marks = make(map[string]api.Mark, len(userIDs))
+	s.rotation = make(map[api.Mark][]string, len(sides))
+	assign := func(userID string, mark api.Mark) {
+		s.marks[userID] = mark
+		s.rotation[mark] = append(s.rotation[mark], userID)
+	}
+	for _, userID := range userIDs {
+		if team, ok := s.teams[userID]; ok && team >= 0 && team < len(sides) && len(s.rotation[sides[team]]) < size {
+			assign(userID, sides[team])
+		}
+	}
+	for _, userID := range userIDs {
+		if _, ok := s.marks[userID]; ok {
+			continue
+		}
+		for _, mark := range sides {
+			if len(s.rotation[mark]) < size {
+				assign(userID, mark)
+				break
+			}
+		}
+	}
+}
+
+// Rebuild the turn rotation from the mark assignments, for games saved before rotations were.
+func rotationFromMarks(marks map[string]api.Mark) map[api.Mark][]string {
+	userIDs := make([]string, 0, len(marks))
+	for userID := range marks {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+	rotation := make(map[api.Mark][]string)
+	for _, userID := range userIDs {
+		rotation[marks[userID]] = append(rotation[marks[userID]], userID)
+	}
+	return rotation
+}
+
+// The player whose turn it is. Teammates sharing a mark take turns in a fixed rotation.
+func (s *MatchState) currentPlayer() string {
+	players := s.rotation[s.mark]
+	if len(players) == 0 {
+		return ""
+	}
+	var played int
+	for _, move := range s.moves {
+		if move.Mark == s.mark {
+			played++
+		}
+	}
+	return players[played%len(players)]
+}
+
+// The players on the winning side, if any.
+func (s *MatchState) winners() []string {
+	if s.winner == api.Mark_MARK_UNSPECIFIED {
+		return nil
+	}
+	return s.rotation[s.winner]
+}
+
+// The turn rotation of each mark in team games, for clients to show who plays next.
+func (s *MatchState) rotationsMessage() map[int32]*api.TeamRotation {
+	if s.label.teamSize() < 2 {
+		return nil
+	}
+	rotations := make(map[int32]*api.TeamRotation, len(s.rotation))
+	for mark, userIDs := range s.rotation {
+		rotations[int32(mark)] = &api.TeamRotation{UserIds: userIDs}
+	}
+	return rotations
+}
+
+// The other players sharing a player's mark.
+func (s *MatchState) teammates(userID string) []string {
+	var teammates []string
+	for _, other := range s.rotation[s.marks[userID]] {
+		if other != userID {
+			teammates = append(teammates, other)
+		}
+	}
+	return teammates
+}
+
+// Recipients for a chat message from the sender: everyone else, or just their teammates, leaving out anyone who has
+// muted the sender.
+func (s *MatchState) chatRecipients(senderID string, teamOnly bool) []runtime.Presence {
+	var recipients []runtime.Presence
+	for userID, presence := range s.presences {
+		if presence == nil || userID == senderID || s.mutes[userID][senderID] {
+			continue
+		}
+		if teamOnly && (s.marks[userID] == api.Mark_MARK_UNSPECIFIED || s.marks[userID] != s.marks[senderID]) {
+			continue
+		}
+		recipients = append(recipients, presence)
+	}
+	return recipients
+}
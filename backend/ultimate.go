@@ -0,0 +1,91 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// Ultimate tic-tac-toe is played on a 3x3 grid of 3x3 sub-boards, stored as 81 cells with sub-board position / 9
+// holding cell position % 9. The cell a player picks sends their opponent to the matching sub-board, unless that
+// sub-board is already decided, in which case the opponent may play in any open sub-board. Sub-boards are won like
+// a classic game, and a line of won sub-boards wins the game.
+type ultimateRules struct{}
+
+const ultimateAnyBoard = -1
+
+// Each sub-board is a layer, so positions and coordinates line up.
+func (ultimateRules) shape() *api.BoardShape {
+	return &api.BoardShape{Layers: 9, Rows: 3, Columns: 3}
+}
+
+func (ultimateRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != move.Mark {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	if reason := checkPosition(board, move.Position); reason != api.RejectReason_REJECT_REASON_UNSPECIFIED {
+		return reason
+	}
+	activeBoard, winners := ultimateState(board, moves)
+	subBoard := move.Position / 9
+	if winners[subBoard] != api.Mark_MARK_UNSPECIFIED || (activeBoard != ultimateAnyBoard && activeBoard != subBoard) {
+		return api.RejectReason_REJECT_REASON_INVALID_POSITION
+	}
+	return api.RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+func (ultimateRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	winners := ultimateSubBoardWinners(board)
+	if line := winningLine(winners, move.Mark); line != nil {
+		return true, move.Mark, line
+	}
+	for subBoard, winner := range winners {
+		if winner == api.Mark_MARK_UNSPECIFIED && !isBoardFull(ultimateSubBoard(board, int32(subBoard))) {
+			return false, api.Mark_MARK_UNSPECIFIED, nil
+		}
+	}
+	return true, api.Mark_MARK_UNSPECIFIED, nil
+}
+
+func ultimateSubBoard(board []api.Mark, subBoard int32) []api.Mark {
+	return board[subBoard*9 : (subBoard+1)*9]
+}
+
+// The winner of each sub-board, unspecified while it is undecided or if it was drawn.
+func ultimateSubBoardWinners(board []api.Mark) []api.Mark {
+	winners := make([]api.Mark, 9)
+	for subBoard := range winners {
+		cells := ultimateSubBoard(board, int32(subBoard))
+		for _, mark := range []api.Mark{api.Mark_MARK_X, api.Mark_MARK_O} {
+			if winningLine(cells, mark) != nil {
+				winners[subBoard] = mark
+			}
+		}
+	}
+	return winners
+}
+
+// The sub-board the player to move must play in, or ultimateAnyBoard, along with the winner of each sub-board.
+func ultimateState(board []api.Mark, moves []*moveRecord) (int32, []api.Mark) {
+	winners := ultimateSubBoardWinners(board)
+	if len(moves) == 0 {
+		return ultimateAnyBoard, winners
+	}
+	target := moves[len(moves)-1].Position % 9
+	if winners[target] != api.Mark_MARK_UNSPECIFIED || isBoardFull(ultimateSubBoard(board, target)) {
+		return ultimateAnyBoard, winners
+	}
+	return target, winners
+}
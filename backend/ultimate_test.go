@@ -0,0 +1,131 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// An ultimate board with each of the given sub-boards, pictured as for testBoard, laid out from the first.
+func testUltimateBoard(subBoards map[int32]string) []api.Mark {
+	board := make([]api.Mark, 81)
+	for subBoard, rows := range subBoards {
+		copy(ultimateSubBoard(board, subBoard), testBoard(rows))
+	}
+	return board
+}
+
+func testMoves(positions ...int32) []*moveRecord {
+	moves := make([]*moveRecord, 0, len(positions))
+	for _, position := range positions {
+		moves = append(moves, &moveRecord{Position: position})
+	}
+	return moves
+}
+
+func TestUltimateState(t *testing.T) {
+	tests := []struct {
+		name      string
+		subBoards map[int32]string
+		moves     []*moveRecord
+		want      int32
+	}{
+		{"first move is free", nil, nil, ultimateAnyBoard},
+		{"sent to the matching sub-board", map[int32]string{1: "... .X. ..."}, testMoves(13), 4},
+		{"sent to the same sub-board", map[int32]string{4: "... .X. ..."}, testMoves(40), 4},
+		{"won sub-board frees the move", map[int32]string{1: "... .X. ...", 4: "XXX OO. ..."}, testMoves(13), ultimateAnyBoard},
+		{"full sub-board frees the move", map[int32]string{1: "... .X. ...", 4: "XOX XOO OXX"}, testMoves(13), ultimateAnyBoard},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ultimateState(testUltimateBoard(tt.subBoards), tt.moves); got != tt.want {
+				t.Errorf("ultimateState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUltimateSubBoardWinners(t *testing.T) {
+	board := testUltimateBoard(map[int32]string{
+		0: "XXX OO. ...",
+		3: "O.. XO. X.O",
+		5: "XOX XOO OXX",
+		8: "XX. OO. ...",
+	})
+	want := []api.Mark{api.Mark_MARK_X, 0, 0, api.Mark_MARK_O, 0, 0, 0, 0, 0}
+	if got := ultimateSubBoardWinners(board); !slices.Equal(got, want) {
+		t.Errorf("ultimateSubBoardWinners() = %v, want %v", got, want)
+	}
+}
+
+func TestUltimateCheckMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		subBoards map[int32]string
+		moves     []*moveRecord
+		position  int32
+		piece     api.Mark
+		want      api.RejectReason
+	}{
+		{"anywhere on the first move", nil, nil, 80, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"in the target sub-board", map[int32]string{1: "... .X. ..."}, testMoves(13), 40, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"outside the target sub-board", map[int32]string{1: "... .X. ..."}, testMoves(13), 0, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+		{"anywhere open when sent to a won sub-board", map[int32]string{1: "... .X. ...", 4: "XXX OO. ..."}, testMoves(13), 0, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"into a won sub-board", map[int32]string{1: "... .X. ...", 4: "XXX OO. ..."}, testMoves(13), 42, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+		{"onto a taken cell", map[int32]string{1: "... .X. ...", 4: "O.. ... ..."}, testMoves(13), 36, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+		{"off the board", nil, nil, 81, api.Mark_MARK_X, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+		{"with the opponent's mark", nil, nil, 0, api.Mark_MARK_O, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: api.Mark_MARK_X, Piece: tt.piece, Position: tt.position}
+			if got := (ultimateRules{}).checkMove(testUltimateBoard(tt.subBoards), tt.moves, move); got != tt.want {
+				t.Errorf("checkMove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUltimateResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		subBoards  map[int32]string
+		over       bool
+		winner     api.Mark
+		winnerLine []int32
+	}{
+		{"empty board", nil, false, 0, nil},
+		{"sub-board won but no line", map[int32]string{0: "XXX ... ...", 1: "XXX ... ..."}, false, 0, nil},
+		{"line of won sub-boards", map[int32]string{0: "XXX ... ...", 4: "X.. .X. ..X", 8: "..X ..X ..X"}, true, api.Mark_MARK_X, []int32{0, 4, 8}},
+		{"line of the opponent's sub-boards", map[int32]string{0: "OOO ... ...", 1: "OOO ... ...", 2: "OOO ... ..."}, false, 0, nil},
+		{"every sub-board decided without a line", map[int32]string{
+			0: "XXX ... ...", 1: "OOO ... ...", 2: "XXX ... ...",
+			3: "XXX ... ...", 4: "OOO ... ...", 5: "OOO ... ...",
+			6: "OOO ... ...", 7: "XXX ... ...", 8: "XOX XOO OXX",
+		}, true, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: api.Mark_MARK_X, Piece: api.Mark_MARK_X}
+			over, winner, line := (ultimateRules{}).result(testUltimateBoard(tt.subBoards), nil, move)
+			if over != tt.over || winner != tt.winner || !slices.Equal(line, tt.winnerLine) {
+				t.Errorf("result() = %v, %v, %v, want %v, %v, %v", over, winner, line, tt.over, tt.winner, tt.winnerLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+// Misère: played like classic, but whoever completes a line loses.
+type misereRules struct {
+	classicRules
+}
+
+func (misereRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	if line := winningLine(board, move.Mark); line != nil {
+		return true, otherMark(move.Mark), line
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// Wild: either player may place either mark, and whoever completes a line of either mark wins.
+type wildRules struct {
+	classicRules
+}
+
+func (wildRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != api.Mark_MARK_X && move.Piece != api.Mark_MARK_O {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	return checkPosition(board, move.Position)
+}
+
+func (wildRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	if line := winningLine(board, move.Piece); line != nil {
+		return true, move.Mark, line
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// Numerical: X places the odd digits and O the even ones, each digit at most once. The board records who placed each
+// digit, and whoever completes a line of three digits summing to 15 wins.
+type numericalRules struct {
+	classicRules
+}
+
+const numericalTarget = 15
+
+func (numericalRules) checkMove(board []api.Mark, moves []*moveRecord, move *moveRecord) api.RejectReason {
+	if move.Piece != move.Mark || move.Value < 1 || move.Value > 9 {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	if odd := move.Value%2 == 1; odd != (move.Mark == api.Mark_MARK_X) {
+		return api.RejectReason_REJECT_REASON_INVALID_PIECE
+	}
+	for _, played := range moves {
+		if played.Value == move.Value {
+			return api.RejectReason_REJECT_REASON_INVALID_PIECE
+		}
+	}
+	return checkPosition(board, move.Position)
+}
+
+func (numericalRules) result(board []api.Mark, moves []*moveRecord, move *moveRecord) (bool, api.Mark, []int32) {
+	values := numericalValues(len(board), moves)
+lineCheck:
+	for _, winningPosition := range winningPositions {
+		var sum int32
+		for _, position := range winningPosition {
+			if values[position] == 0 {
+				continue lineCheck
+			}
+			sum += values[position]
+		}
+		if sum == numericalTarget {
+			return true, move.Mark, winningPosition
+		}
+	}
+	return isBoardFull(board), api.Mark_MARK_UNSPECIFIED, nil
+}
+
+// The digit in each cell of a numerical game, zero if empty.
+func numericalValues(size int, moves []*moveRecord) []int32 {
+	values := make([]int32, size)
+	for _, move := range moves {
+		if move.Position >= 0 && int(move.Position) < size {
+			values[move.Position] = move.Value
+		}
+	}
+	return values
+}
@@ -0,0 +1,175 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/heroiclabs/nakama-project-template/api"
+)
+
+func TestMisereResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		board      string
+		mark       api.Mark
+		over       bool
+		winner     api.Mark
+		winnerLine []int32
+	}{
+		{"line loses for its maker", "XXX OO. ...", api.Mark_MARK_X, true, api.Mark_MARK_O, []int32{0, 1, 2}},
+		{"line loses for O too", "XX. OOO X..", api.Mark_MARK_O, true, api.Mark_MARK_X, []int32{3, 4, 5}},
+		{"opponent's line is not checked", "XXX OO. ...", api.Mark_MARK_O, false, 0, nil},
+		{"no line", "XO. ... ...", api.Mark_MARK_O, false, 0, nil},
+		{"full board without a line", "XOX XOO OXX", api.Mark_MARK_X, true, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: tt.mark, Piece: tt.mark}
+			over, winner, line := (misereRules{}).result(testBoard(tt.board), nil, move)
+			if over != tt.over || winner != tt.winner || !slices.Equal(line, tt.winnerLine) {
+				t.Errorf("result() = %v, %v, %v, want %v, %v, %v", over, winner, line, tt.over, tt.winner, tt.winnerLine)
+			}
+		})
+	}
+}
+
+func TestWildCheckMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		mark     api.Mark
+		piece    api.Mark
+		position int32
+		want     api.RejectReason
+	}{
+		{"own mark", api.Mark_MARK_X, api.Mark_MARK_X, 2, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"opponent's mark", api.Mark_MARK_X, api.Mark_MARK_O, 2, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"no mark", api.Mark_MARK_O, api.Mark_MARK_UNSPECIFIED, 2, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"taken cell", api.Mark_MARK_O, api.Mark_MARK_X, 0, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: tt.mark, Piece: tt.piece, Position: tt.position}
+			if got := (wildRules{}).checkMove(testBoard("XO. ... ..."), nil, move); got != tt.want {
+				t.Errorf("checkMove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWildResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		board      string
+		mark       api.Mark
+		piece      api.Mark
+		over       bool
+		winner     api.Mark
+		winnerLine []int32
+	}{
+		{"line of own mark", "XXX OO. ...", api.Mark_MARK_X, api.Mark_MARK_X, true, api.Mark_MARK_X, []int32{0, 1, 2}},
+		{"line of the opponent's mark", "OOO XX. ...", api.Mark_MARK_X, api.Mark_MARK_O, true, api.Mark_MARK_X, []int32{0, 1, 2}},
+		{"line of the piece not placed", "XXX OO. ...", api.Mark_MARK_O, api.Mark_MARK_O, false, 0, nil},
+		{"full board without a line", "XOX XOO OXX", api.Mark_MARK_O, api.Mark_MARK_X, true, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: tt.mark, Piece: tt.piece}
+			over, winner, line := (wildRules{}).result(testBoard(tt.board), nil, move)
+			if over != tt.over || winner != tt.winner || !slices.Equal(line, tt.winnerLine) {
+				t.Errorf("result() = %v, %v, %v, want %v, %v, %v", over, winner, line, tt.over, tt.winner, tt.winnerLine)
+			}
+		})
+	}
+}
+
+// Numerical moves placing each digit at its position in turn, X first, with the board they leave.
+func testDigits(digits ...[2]int32) ([]api.Mark, []*moveRecord) {
+	board := make([]api.Mark, 9)
+	moves := make([]*moveRecord, 0, len(digits))
+	for i, digit := range digits {
+		mark := api.Mark_MARK_X
+		if i%2 == 1 {
+			mark = api.Mark_MARK_O
+		}
+		board[digit[0]] = mark
+		moves = append(moves, &moveRecord{Mark: mark, Piece: mark, Position: digit[0], Value: digit[1]})
+	}
+	return board, moves
+}
+
+func TestNumericalCheckMove(t *testing.T) {
+	board, moves := testDigits([2]int32{4, 5}, [2]int32{0, 2})
+	tests := []struct {
+		name     string
+		mark     api.Mark
+		position int32
+		value    int32
+		want     api.RejectReason
+	}{
+		{"odd digit for X", api.Mark_MARK_X, 8, 7, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"even digit for O", api.Mark_MARK_O, 8, 8, api.RejectReason_REJECT_REASON_UNSPECIFIED},
+		{"even digit for X", api.Mark_MARK_X, 8, 6, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"odd digit for O", api.Mark_MARK_O, 8, 3, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"digit already played", api.Mark_MARK_X, 8, 5, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"zero", api.Mark_MARK_O, 8, 0, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"above nine", api.Mark_MARK_X, 8, 11, api.RejectReason_REJECT_REASON_INVALID_PIECE},
+		{"taken cell", api.Mark_MARK_X, 4, 7, api.RejectReason_REJECT_REASON_INVALID_POSITION},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			move := &moveRecord{Mark: tt.mark, Piece: tt.mark, Position: tt.position, Value: tt.value}
+			if got := (numericalRules{}).checkMove(board, moves, move); got != tt.want {
+				t.Errorf("checkMove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	t.Run("piece other than own mark", func(t *testing.T) {
+		move := &moveRecord{Mark: api.Mark_MARK_X, Piece: api.Mark_MARK_O, Position: 8, Value: 7}
+		if got := (numericalRules{}).checkMove(board, moves, move); got != api.RejectReason_REJECT_REASON_INVALID_PIECE {
+			t.Errorf("checkMove() = %v, want %v", got, api.RejectReason_REJECT_REASON_INVALID_PIECE)
+		}
+	})
+}
+
+func TestNumericalResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		digits     [][2]int32
+		over       bool
+		winner     api.Mark
+		winnerLine []int32
+	}{
+		{"X completes 15 with O's digits", [][2]int32{{4, 3}, {3, 6}, {8, 5}, {6, 8}, {0, 1}}, true, api.Mark_MARK_X, []int32{0, 3, 6}},
+		{"O completes 15 with X's digit", [][2]int32{{0, 1}, {3, 6}, {4, 3}, {6, 8}}, true, api.Mark_MARK_O, []int32{0, 3, 6}},
+		{"full line not summing to 15", [][2]int32{{0, 1}, {1, 2}, {2, 3}}, false, 0, nil},
+		{"two digits cannot reach 15", [][2]int32{{0, 9}, {1, 6}}, false, 0, nil},
+		{"full board without 15", [][2]int32{
+			{0, 1}, {1, 2}, {2, 3},
+			{3, 4}, {4, 9}, {5, 6},
+			{6, 5}, {7, 8}, {8, 7},
+		}, true, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, moves := testDigits(tt.digits...)
+			over, winner, line := (numericalRules{}).result(board, moves, moves[len(moves)-1])
+			if over != tt.over || winner != tt.winner || !slices.Equal(line, tt.winnerLine) {
+				t.Errorf("result() = %v, %v, %v, want %v, %v, %v", over, winner, line, tt.over, tt.winner, tt.winnerLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2020 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama-project-template/api"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	nkapi "github.com/heroiclabs/nakama-common/api"
+)
+
+const (
+	walletCurrency        = "coins"
+	walletStartingBalance = 1000
+	walletMaxStake        = 10000
+
+	walletLedgerDefaultLimit = 20
+	walletLedgerMaxLimit     = 100
+
+	// Reasons recorded in wallet ledger metadata.
+	walletReasonStartingBalance = "starting_balance"
+	walletReasonStake           = "match_stake"
+	walletReasonPayout          = "match_payout"
+	walletReasonRefund          = "match_refund"
+)
+
+func registerWalletHooks(initializer runtime.Initializer) error {
+	if err := initializer.RegisterAfterAuthenticateCustom(func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, out *nkapi.Session, in *nkapi.AuthenticateCustomRequest) error {
+		return grantStartingBalance(ctx, logger, nk, out)
+	}); err != nil {
+		return err
+	}
+	if err := initializer.RegisterAfterAuthenticateDevice(func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, out *nkapi.Session, in *nkapi.AuthenticateDeviceRequest) error {
+		return grantStartingBalance(ctx, logger, nk, out)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Credit newly created accounts with their starting balance.
+func grantStartingBalance(ctx context.Context, logger runtime.Logger, nk runtime.NakamaModule, out *nkapi.Session) error {
+	if !out.GetCreated() {
+		return nil
+	}
+
+	userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+	if !ok {
+		logger.Error("context did not contain user ID.")
+		return nil
+	}
+
+	changeset := map[string]int64{walletCurrency: walletStartingBalance}
+	metadata := map[string]interface{}{"reason": walletReasonStartingBalance}
+	if _, _, err := nk.WalletUpdate(ctx, userID, changeset, metadata, true); err != nil {
+		logger.WithField("err", err).Error("nk.WalletUpdate starting balance error.")
+	}
+	return nil
+}
+
+// Read a user's current balance of the game currency.
+func walletBalance(ctx context.Context, nk runtime.NakamaModule, userID string) (int64, error) {
+	account, err := nk.AccountGetId(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	wallet := map[string]int64{}
+	if account.Wallet != "" {
+		if err := json.Unmarshal([]byte(account.Wallet), &wallet); err != nil {
+			return 0, err
+		}
+	}
+	return wallet[walletCurrency], nil
+}
+
+// Take the stake from each player and hold it for the duration of the game. If any player cannot pay, all stakes
+// taken so far are refunded and that player's user ID is returned.
+func walletEscrowStakes(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger, matchID string, stake int64, userIDs []string) (map[string]int64, string) {
+	escrow := make(map[string]int64, len(userIDs))
+	if stake <= 0 {
+		return escrow, ""
+	}
+
+	for _, userID := range userIDs {
+		changeset := map[string]int64{walletCurrency: -stake}
+		metadata := map[string]interface{}{"reason": walletReasonStake, "match_id": matchID}
+		if _, _, err := nk.WalletUpdate(ctx, userID, changeset, metadata, true); err != nil {
+			logger.Info("player %v could not pay stake: %v", userID, err)
+			walletSettleStakes(ctx, nk, logger, matchID, escrow, nil)
+			return nil, userID
+		}
+		escrow[userID] = stake
+	}
+	return escrow, ""
+}
+
+// Release escrowed stakes, splitting the whole pot between the winners or refunding every player when there are none.
+// Any remainder from an uneven split goes to the first winner.
+func walletSettleStakes(ctx context.Context, nk runtime.NakamaModule, logger runtime.Logger, matchID string, escrow map[string]int64, winnerIDs []string) {
+	if len(escrow) == 0 {
+		return
+	}
+
+	var paid []string
+	for _, winnerID := range winnerIDs {
+		if _, ok := escrow[winnerID]; ok {
+			paid = append(paid, winnerID)
+		}
+	}
+	if len(paid) > 0 {
+		var pot int64
+		for _, amount := range escrow {
+			pot += amount
+		}
+		share := pot / int64(len(paid))
+		for i, winnerID := range paid {
+			amount := share
+			if i == 0 {
+				amount += pot - share*int64(len(paid))
+			}
+			changeset := map[string]int64{walletCurrency: amount}
+			metadata := map[string]interface{}{"reason": walletReasonPayout, "match_id": matchID}
+			if _, _, err := nk.WalletUpdate(ctx, winnerID, changeset, metadata, true); err != nil {
+				logger.Error("error paying out stake to %v: %v", winnerID, err)
+			}
+		}
+	} else {
+		for userID, amount := range escrow {
+			changeset := map[string]int64{walletCurrency: amount}
+			metadata := map[string]interface{}{"reason": walletReasonRefund, "match_id": matchID}
+			if _, _, err := nk.WalletUpdate(ctx, userID, changeset, metadata, true); err != nil {
+				logger.Error("error refunding stake to %v: %v", userID, err)
+			}
+		}
+	}
+
+	for userID := range escrow {
+		delete(escrow, userID)
+	}
+}
+
+// List the caller's wallet ledger along with their current balance.
+func rpcWalletLedger(marshaler *protojson.MarshalOptions, unmarshaler *protojson.UnmarshalOptions) nakamaRpcFunc {
+	return func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, payload string) (string, error) {
+		userID, ok := ctx.Value(runtime.RUNTIME_CTX_USER_ID).(string)
+		if !ok {
+			return "", errNoUserIdFound
+		}
+
+		request := &api.RpcWalletLedgerRequest{}
+		if payload != "" {
+			if err := unmarshaler.Unmarshal([]byte(payload), request); err != nil {
+				return "", errUnmarshal
+			}
+		}
+
+		limit := int(request.Limit)
+		if limit <= 0 {
+			limit = walletLedgerDefaultLimit
+		} else if limit > walletLedgerMaxLimit {
+			limit = walletLedgerMaxLimit
+		}
+
+		items, cursor, err := nk.WalletLedgerList(ctx, userID, limit, request.Cursor)
+		if err != nil {
+			logger.Error("error listing wallet ledger: %v", err)
+			return "", errInternalError
+		}
+
+		balance, err := walletBalance(ctx, nk, userID)
+		if err != nil {
+			logger.Error("error reading wallet balance: %v", err)
+			return "", errInternalError
+		}
+
+		response := &api.RpcWalletLedgerResponse{
+			Items:   make([]*api.WalletLedgerItem, 0, len(items)),
+			Cursor:  cursor,
+			Balance: balance,
+		}
+		for _, item := range items {
+			metadata, err := json.Marshal(item.GetMetadata())
+			if err != nil {
+				logger.Error("error encoding ledger metadata: %v", err)
+				return "", errMarshal
+			}
+			response.Items = append(response.Items, &api.WalletLedgerItem{
+				Id:         item.GetID(),
+				Changeset:  item.GetChangeset(),
+				Metadata:   string(metadata),
+				CreateTime: item.GetCreateTime(),
+			})
+		}
+
+		buf, err := marshaler.Marshal(response)
+		if err != nil {
+			logger.Error("error marshaling response payload: %v", err.Error())
+			return "", errMarshal
+		}
+		return string(buf), nil
+	}
+}
@@ -61,7 +61,7 @@ class Nakama {
 
         // First try to find an open match
         console.log(`{"fast":${fast ? 1 : 0}}`);
-        const matches = await this.client.listMatches(this.session, 1, true, `+label.open:1 +label.fast:${fast ? 1 : 0}`, 1, 2);
+        const matches = await this.client.listMatches(this.session, 1, true, `+label.open:1 +label.fast:${fast ? 1 : 0} +label.stake:0 +label.mode:0 +label.variant:0 +label.players:2 +label.win_rule:0 +label.team_size:1 +label.time_control:0`, 1, 2);
 
         console.log(matches);
 